@@ -4,16 +4,147 @@ const (
 	RegionSize = 50
 	TileSize   = 30
 
+	DaysPerSeason = 7
+	DaysPerYear   = DaysPerSeason * 4
+
 	CharacterNeedsUpdateInterval     = 1
 	CharacterObjectiveUpdateInterval = 1
 	CharacterObjectiveResetInterval  = 60
 	CharacterTaskUpdateInterval      = 1
+	CharacterInterruptCheckInterval  = 10 // how often characters check if a more important objective should interrupt their task
+	MaxPlanSteps                     = 10
+
+	NeedCap            = 127
+	NeedDamage         = 1
+	NeedDamageInterval = 5 // in ticks
+
+	MaxHealth           = 100
+	HealthRecoveryInBed = 2
+	InjuryHealingInBed  = 1
+	DrowningChance      = 0.05
+	DrowningSeverity    = 5
+	BurnChance          = 0.1
+	BurnSeverity        = 10
+	LightningChance     = 0.001
+	LightningSeverity   = 40
+
+	MaxEvents = 200
+
+	BaseMood                = 50
+	MoodChangeRate          = 0.5 // per tick
+	MoodCriticalNeedPenalty = 10
+	MoodSatisfiedNeedBonus  = 3
+	RoomMoodBonus           = 5
+	FurnitureMoodBonus      = 2
+	MaxFurnitureMoodBonus   = 10
+	MoodBreakThreshold      = 25 // below it characters refuse work and wander
+	WanderRadius            = 5
+	GroundSleepRecovery     = 3 // sleep recovered per tick without a bed, 5 in a bed
+
+	BaseCarryWeight          = 20  // in kg
+	BaseCarryVolume          = 20  // in liters
+	CarryWeightPerSkillLevel = 1.5 // of hauling
+	MinCarryHealthFactor     = 0.5 // share of the capacity kept by badly injured characters
+
+	MaxSkillLevel           = 20
+	SkillExperiencePerTick  = 2
+	SkillExperiencePerLevel = 100  // level n to n+1 requires (n+1) * SkillExperiencePerLevel
+	SkillSpeedPerLevel      = 0.1  // task progress multiplier, level 10 works twice as fast
+	SkillQualityPerLevel    = 0.03 // output multiplier, e.g. nutrition of harvested food
+
+	StartingCharacters     = 1
+	GeneratedMinAge        = 18
+	GeneratedMaxAge        = 60
+	MaxTraits              = 3
+	MaxRandomStartingSkill = 3
+
+	AdultAge                 = 16
+	ElderAge                 = 60
+	OldAgeDeathAge           = 70   // elders can die of old age from this age
+	OldAgeDeathChancePerYear = 0.01 // daily chance of dying grows each year past OldAgeDeathAge
+	ChildWorkSpeed           = 0.5
+	ElderWorkSpeed           = 0.75
+	CoupleChance             = 0.05 // daily chance for two single adults to become a couple
+	MaxCoupleAgeGap          = 15
+	BirthChance              = 0.003 // daily chance for a couple to have a child, about 2-3 children in a lifetime
+	MaxColonySize            = 50
+	MaxParentAge             = 45
+	InheritTraitChance       = 0.5
+
+	FriendOpinion        = 30 // friends share beds, eat together and help each other
+	RivalOpinion         = -30
+	CoupleOpinion        = 50 // both characters need this opinion of each other to become a couple
+	ParentChildOpinion   = 50 // starting opinion between parents and their newborn
+	ChatProgress         = 10 // per tick
+	ChatSocialRecovery   = 10 // per tick
+	ChatOpinionGain      = 3  // base opinion change after a chat, before traits
+	SharedTraitOpinion   = 2  // per trait both characters have
+	ClashingTraitOpinion = -4 // per trait of one excluded by a trait of the other
+	ArgumentChance       = 0.15
+	ArgumentOpinion      = -8
+	EatTogetherRadius    = 10
+
+	UtilityDistancePenalty    = 0.5 // score lost per tile to the needed resource
+	UtilityMaxDistancePenalty = 20
+	UtilityScanDistance       = 20  // how far characters look for resources when scoring objectives
+	UtilityDistanceCacheTime  = 10  // in ticks, how long the distance to an objective's resource is kept before scanning again
+	UtilityHysteresis         = 1.2 // score multiplier of the objective being pursued
+	ShelterUtility            = 90
+	MakeFoodUtility           = 30 // doubled when starving
+	BuildUtility              = 40
+	WanderUtility             = 30
+	WorkUtility               = 35
+	NightSleepUtility         = 50 // minimum sleep score in the sleep block, below a critical need once weighted
+
+	WakeUpHour    = 6
+	WorkStartHour = 8
+	WorkEndHour   = 18
+	BedTimeHour   = 22
+
+	ComfortTemperature    = 18 // in Celsius
+	ColdTemperature       = 5
+	DailyTemperatureSwing = 6
+	RoomInsulation        = 0.5 // share of the gap to comfort temperature closed by being indoor
+	MaxRoomSize           = 200
+	FireplaceHeat         = 20
+	FireplaceRadius       = 3
+
+	PlantOldAgeDeathChance  = 0.05 // daily, once a plant reached its max age
+	MaxPlants               = 500  // wild plants stop spreading past it
+	FieldGrowthRate         = 10
+	FieldWateredGrowthBonus = 5
+	FieldDefaultSize        = 10
+	PlantSeedsAtLeast       = 5
+	HarvestSeedCount        = 2 // seeds given back by a harvested crop
+
+	JobUpdateInterval        = 30
+	DefaultWorkPriority      = 3
+	MaxWorkPriority          = 4
+	BuildProgressPerTick     = 2
+	RepairProgressPerTick    = 5
+	RepairThreshold          = 70 // condition below which a repair job is posted
+	StructureWearPerDay      = 1
+	ReservationTimeout       = 240 // in ticks, reservations of lost tasks are dropped after it
+	ChopProgressPerTick      = 2
+	YoungTreeWoodFactor      = 0.5 // share of its wood a just grown tree gives, it gets more with age up to all of it
+	MineProgressPerTick      = 2
+	MineStoneYield           = 2
+	TerraformProgressPerTick = 4
+	FloorStoneCost           = 1
+	FillStoneCost            = 2
+	ToolScanDistance         = 20  // how far characters look for a better tool before a job
+	ToolWearChance           = 0.1 // per tick of work sped up by a tool, to lose 1 durability
+
+	ItemDecayInterval     = 60  // in ticks
+	StorageDecayFactor    = 0.3 // decay speed of items in a storage
+	ColdDecayFactor       = 0.5 // decay speed at ColdTemperature or below, e.g. in winter
+	FoodPoisoningChance   = 0.3 // when eating rotten food
+	FoodPoisoningSeverity = 10
 
-	NeedFoodMax  = 100
-	NeedWaterMax = 100
-	NeedSleepMax = 100
+	AmbitionUpdateInterval = 60
+	MasterSkillLevel       = 10
+	StockpileAmbitionCount = 20
 
-	FieldGrowthRate   = 10
-	FieldDefaultSize  = 10
-	PlantSeedsAtLeast = 5
+	WeatherMinDuration = 120 // in ticks
+	WeatherMaxDuration = 720
 )
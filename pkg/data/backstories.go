@@ -0,0 +1,44 @@
+package data
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// BackstoryDefinition is one half of a generated character's story, e.g. a childhood
+type BackstoryDefinition struct {
+	Title       string        `json:"title"`
+	Description string        `json:"description"`
+	Skills      map[int]uint8 `json:"skills"` // SkillType -> starting levels
+}
+
+// BackstoryDataFile represents the structure of the JSON file
+type BackstoryDataFile struct {
+	Names      []string              `json:"names"`
+	Childhoods []BackstoryDefinition `json:"childhoods"`
+	Adulthoods []BackstoryDefinition `json:"adulthoods"`
+}
+
+// Backstories holds everything used to generate new characters
+var Backstories BackstoryDataFile
+
+// LoadBackstories loads names and backstories from the JSON file
+func LoadBackstories() error {
+	file, err := os.Open("pkg/data/backstories.json")
+	if err != nil {
+		return fmt.Errorf("failed to open backstories.json: %w", err)
+	}
+	defer file.Close()
+
+	decoder := json.NewDecoder(file)
+	if err := decoder.Decode(&Backstories); err != nil {
+		return fmt.Errorf("failed to decode backstories.json: %w", err)
+	}
+	if len(Backstories.Names) == 0 || len(Backstories.Childhoods) == 0 || len(Backstories.Adulthoods) == 0 {
+		return fmt.Errorf("backstories.json needs at least one name, childhood and adulthood")
+	}
+
+	fmt.Printf("Loaded %d names, %d childhoods and %d adulthoods\n", len(Backstories.Names), len(Backstories.Childhoods), len(Backstories.Adulthoods))
+	return nil
+}
@@ -8,11 +8,22 @@ import (
 
 // ItemDefinition represents an item configuration loaded from JSON
 type ItemDefinition struct {
-	ItemType   int    `json:"itemType"`
-	Variant    int16  `json:"variant"`
-	Name       string `json:"name"`
-	Efficiency uint8  `json:"efficiency"` // e.g. nutrition value for food
-	StackSize  uint8  `json:"stackSize"`
+	ItemType    int     `json:"itemType"`
+	Variant     int16   `json:"variant"`
+	Name        string  `json:"name"`
+	Efficiency  uint8   `json:"efficiency"` // e.g. nutrition value for food
+	StackSize   uint8   `json:"stackSize"`
+	Raw         bool    `json:"raw"`         // food that should be cooked, eating it lowers mood
+	Weight      float32 `json:"weight"`      // in kg, per unit
+	Volume      float32 `json:"volume"`      // in liters, per unit
+	CarryWeight float32 `json:"carryWeight"` // optional, weight capacity added when carried, e.g. a basket
+	CarryVolume float32 `json:"carryVolume"` // optional, volume capacity added when carried
+
+	ToolSpeeds map[int]float32 `json:"toolSpeeds"` // optional, TaskType -> work speed multiplier when equipped
+
+	DecayDays     float32 `json:"decayDays"`     // optional, days to decay fully outside storage, 0 never decays
+	RottenVariant int16   `json:"rottenVariant"` // variant of the same type it becomes once decayed, -1 if it's gone
+	Rotten        bool    `json:"rotten"`        // spoiled food, eating it lowers mood and can make sick
 }
 
 // ItemDataFile represents the structure of the JSON file
@@ -12,5 +12,20 @@ func LoadAllData() error {
 	if err := LoadItemDefinitions(); err != nil {
 		return fmt.Errorf("failed to load item definitions: %w", err)
 	}
+	if err := LoadNeedDefinitions(); err != nil {
+		return fmt.Errorf("failed to load need definitions: %w", err)
+	}
+	if err := LoadThoughtDefinitions(); err != nil {
+		return fmt.Errorf("failed to load thought definitions: %w", err)
+	}
+	if err := LoadTraitDefinitions(); err != nil {
+		return fmt.Errorf("failed to load trait definitions: %w", err)
+	}
+	if err := LoadRecipeDefinitions(); err != nil {
+		return fmt.Errorf("failed to load recipe definitions: %w", err)
+	}
+	if err := LoadBackstories(); err != nil {
+		return fmt.Errorf("failed to load backstories: %w", err)
+	}
 	return nil
 }
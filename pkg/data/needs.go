@@ -0,0 +1,62 @@
+package data
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+)
+
+// NeedDefinition represents a character need configuration loaded from JSON
+// Need values start at 0 (fully satisfied) and rise over time
+type NeedDefinition struct {
+	NeedType        int     `json:"needType"`
+	Name            string  `json:"name"`
+	DecayRate       float32 `json:"decayRate"`       // how much the need rises per update, 0 if driven by the sim (e.g. warmth)
+	Critical        float32 `json:"critical"`        // the objective is triggered at this value
+	Satisfied       float32 `json:"satisfied"`       // the objective is achieved below this value
+	Objective       int     `json:"objective"`       // ObjectiveType triggered, 0 for none
+	DamageThreshold float32 `json:"damageThreshold"` // health is damaged above this value, 0 for never
+	DamageCause     int     `json:"damageCause"`     // DamageCause recorded when damaging health
+}
+
+// NeedDataFile represents the structure of the JSON file
+type NeedDataFile struct {
+	Needs []NeedDefinition `json:"needs"`
+}
+
+// NeedDefinitions lists all need definitions sorted by NeedType
+var NeedDefinitions []NeedDefinition
+
+// LoadNeedDefinitions loads need definitions from the JSON file
+func LoadNeedDefinitions() error {
+	file, err := os.Open("pkg/data/needs.json")
+	if err != nil {
+		return fmt.Errorf("failed to open needs.json: %w", err)
+	}
+	defer file.Close()
+
+	var data NeedDataFile
+	decoder := json.NewDecoder(file)
+	if err := decoder.Decode(&data); err != nil {
+		return fmt.Errorf("failed to decode needs.json: %w", err)
+	}
+
+	sort.Slice(data.Needs, func(i, j int) bool {
+		return data.Needs[i].NeedType < data.Needs[j].NeedType
+	})
+	NeedDefinitions = data.Needs
+
+	fmt.Printf("Loaded %d need definitions\n", len(data.Needs))
+	return nil
+}
+
+// GetNeedDefinition retrieves a need definition by type
+func GetNeedDefinition(needType int) (*NeedDefinition, bool) {
+	for i := range NeedDefinitions {
+		if NeedDefinitions[i].NeedType == needType {
+			return &NeedDefinitions[i], true
+		}
+	}
+	return nil, false
+}
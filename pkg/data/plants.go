@@ -13,6 +13,21 @@ type PlantDefinition struct {
 	Name       string        `json:"name"`
 	GrowthRate uint8         `json:"growthRate"`
 	Produces   ProductionDef `json:"produces"`
+	Seeds      SeedsDef      `json:"seeds"`   // optional, seeds gathered from the grown plant
+	Wood       uint8         `json:"wood"`    // optional, wood given by an old plant when chopped, younger ones give less
+	Sapling    bool          `json:"sapling"` // optional, a chopped plant leaves a sapling which grows again
+
+	MaxAge         uint16          `json:"maxAge"`         // optional, in days, the plant can die of old age from then, 0 lives forever
+	SpreadChance   float32         `json:"spreadChance"`   // optional, daily chance for the grown plant to seed a nearby tile
+	SpreadRadius   int             `json:"spreadRadius"`   // how far seeds fall
+	Terrains       map[int]float32 `json:"terrains"`       // optional, TileType -> growth multiplier, the plant only grows on and spreads to these
+	DormantSeasons []int           `json:"dormantSeasons"` // optional, Seasons the plant doesn't grow or produce in
+}
+
+// SeedsDef represents the seeds a plant gives
+type SeedsDef struct {
+	Variant int16 `json:"variant"`
+	Count   uint8 `json:"count"`
 }
 
 // ProductionDef represents what a plant produces
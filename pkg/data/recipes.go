@@ -0,0 +1,78 @@
+package data
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+)
+
+// RecipeDefinition represents a crafting recipe loaded from JSON
+type RecipeDefinition struct {
+	RecipeID  int          `json:"recipeID"`
+	Name      string       `json:"name"`
+	Inputs    []RecipeItem `json:"inputs"`    // consumed when the recipe is done
+	Outputs   []RecipeItem `json:"outputs"`   // produced on the structure's tile
+	Structure int          `json:"structure"` // StructureType where it's crafted, e.g. a Workshop
+	Work      float32      `json:"work"`      // amount of work, about the number of ticks at skill level 0
+	Skill     int          `json:"skill"`     // SkillType used and trained
+}
+
+// RecipeItem represents an input or output of a recipe
+type RecipeItem struct {
+	Type    int   `json:"type"`
+	Variant int16 `json:"variant"`
+	Count   uint8 `json:"count"`
+}
+
+// RecipeDataFile represents the structure of the JSON file
+type RecipeDataFile struct {
+	Recipes []RecipeDefinition `json:"recipes"`
+}
+
+// RecipeDefinitions lists all recipe definitions sorted by RecipeID
+var RecipeDefinitions []RecipeDefinition
+
+// LoadRecipeDefinitions loads recipe definitions from the JSON file
+func LoadRecipeDefinitions() error {
+	file, err := os.Open("pkg/data/recipes.json")
+	if err != nil {
+		return fmt.Errorf("failed to open recipes.json: %w", err)
+	}
+	defer file.Close()
+
+	var data RecipeDataFile
+	decoder := json.NewDecoder(file)
+	if err := decoder.Decode(&data); err != nil {
+		return fmt.Errorf("failed to decode recipes.json: %w", err)
+	}
+
+	RecipeDefinitions = data.Recipes
+	sort.Slice(RecipeDefinitions, func(i, j int) bool {
+		return RecipeDefinitions[i].RecipeID < RecipeDefinitions[j].RecipeID
+	})
+
+	fmt.Printf("Loaded %d recipe definitions\n", len(data.Recipes))
+	return nil
+}
+
+// GetRecipeDefinition retrieves a recipe definition by ID
+func GetRecipeDefinition(recipeID int) (*RecipeDefinition, bool) {
+	for i := range RecipeDefinitions {
+		if RecipeDefinitions[i].RecipeID == recipeID {
+			return &RecipeDefinitions[i], true
+		}
+	}
+	return nil, false
+}
+
+// FindRecipeByName retrieves a recipe definition by name, case insensitive
+func FindRecipeByName(name string) (*RecipeDefinition, bool) {
+	for i := range RecipeDefinitions {
+		if strings.EqualFold(RecipeDefinitions[i].Name, name) {
+			return &RecipeDefinitions[i], true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,54 @@
+package data
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// ThoughtDefinition represents a timed mood modifier loaded from JSON
+type ThoughtDefinition struct {
+	ThoughtType int     `json:"thoughtType"`
+	Name        string  `json:"name"`
+	Mood        float32 `json:"mood"`     // mood added while the thought is active
+	Duration    int     `json:"duration"` // in ticks
+}
+
+// ThoughtDataFile represents the structure of the JSON file
+type ThoughtDataFile struct {
+	Thoughts []ThoughtDefinition `json:"thoughts"`
+}
+
+// ThoughtDefinitionsMap maps ThoughtType -> ThoughtDefinition
+var ThoughtDefinitionsMap map[int]ThoughtDefinition
+
+// LoadThoughtDefinitions loads thought definitions from the JSON file
+func LoadThoughtDefinitions() error {
+	file, err := os.Open("pkg/data/thoughts.json")
+	if err != nil {
+		return fmt.Errorf("failed to open thoughts.json: %w", err)
+	}
+	defer file.Close()
+
+	var data ThoughtDataFile
+	decoder := json.NewDecoder(file)
+	if err := decoder.Decode(&data); err != nil {
+		return fmt.Errorf("failed to decode thoughts.json: %w", err)
+	}
+
+	ThoughtDefinitionsMap = make(map[int]ThoughtDefinition)
+	for _, thought := range data.Thoughts {
+		ThoughtDefinitionsMap[thought.ThoughtType] = thought
+	}
+
+	fmt.Printf("Loaded %d thought definitions\n", len(data.Thoughts))
+	return nil
+}
+
+// GetThoughtDefinition retrieves a thought definition by type
+func GetThoughtDefinition(thoughtType int) (*ThoughtDefinition, bool) {
+	if def, ok := ThoughtDefinitionsMap[thoughtType]; ok {
+		return &def, true
+	}
+	return nil, false
+}
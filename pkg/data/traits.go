@@ -0,0 +1,63 @@
+package data
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+)
+
+// TraitDefinition represents a character trait loaded from JSON
+type TraitDefinition struct {
+	TraitType        int             `json:"traitType"`
+	Name             string          `json:"name"`
+	Description      string          `json:"description"`
+	NeedRates        map[int]float32 `json:"needRates"`        // NeedType -> multiplier of the decay rate
+	SkillGains       map[int]float32 `json:"skillGains"`       // SkillType -> multiplier of the experience gained
+	StartingSkills   map[int]uint8   `json:"startingSkills"`   // SkillType -> levels added when generating a character
+	Mood             float32         `json:"mood"`             // permanent mood modifier
+	ScheduleShift    int             `json:"scheduleShift"`    // hours the daily schedule is moved by, negative is earlier
+	ObjectiveWeights map[int]float32 `json:"objectiveWeights"` // ObjectiveType -> multiplier of the utility score
+	Excludes         []int           `json:"excludes"`         // traits a character can't have at the same time
+}
+
+// TraitDataFile represents the structure of the JSON file
+type TraitDataFile struct {
+	Traits []TraitDefinition `json:"traits"`
+}
+
+// TraitDefinitions lists all trait definitions sorted by TraitType
+var TraitDefinitions []TraitDefinition
+
+// LoadTraitDefinitions loads trait definitions from the JSON file
+func LoadTraitDefinitions() error {
+	file, err := os.Open("pkg/data/traits.json")
+	if err != nil {
+		return fmt.Errorf("failed to open traits.json: %w", err)
+	}
+	defer file.Close()
+
+	var data TraitDataFile
+	decoder := json.NewDecoder(file)
+	if err := decoder.Decode(&data); err != nil {
+		return fmt.Errorf("failed to decode traits.json: %w", err)
+	}
+
+	TraitDefinitions = data.Traits
+	sort.Slice(TraitDefinitions, func(i, j int) bool {
+		return TraitDefinitions[i].TraitType < TraitDefinitions[j].TraitType
+	})
+
+	fmt.Printf("Loaded %d trait definitions\n", len(data.Traits))
+	return nil
+}
+
+// GetTraitDefinition retrieves a trait definition by type
+func GetTraitDefinition(traitType int) (*TraitDefinition, bool) {
+	for i := range TraitDefinitions {
+		if TraitDefinitions[i].TraitType == traitType {
+			return &TraitDefinitions[i], true
+		}
+	}
+	return nil, false
+}
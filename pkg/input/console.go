@@ -2,8 +2,11 @@ package input
 
 import (
 	"fmt"
+	"gociv/pkg/config"
+	"gociv/pkg/data"
 	"gociv/pkg/sim"
 	"gociv/pkg/utils"
+	"strconv"
 	"strings"
 
 	rl "github.com/gen2brain/raylib-go/raylib"
@@ -115,6 +118,22 @@ func (c *Console) executeCommand(command string) {
 		c.handleSaveCommand(args)
 	case "load-tiles":
 		c.handleLoadCommand(args)
+	case "weather":
+		c.handleWeatherCommand(args)
+	case "events":
+		c.handleEventsCommand(args)
+	case "spawn":
+		c.handleSpawnCommand(args)
+	case "build":
+		c.handleBuildCommand(args)
+	case "bill":
+		c.handleBillCommand(args)
+	case "bills":
+		c.handleBillsCommand()
+	case "chop":
+		c.handleChopCommand(args)
+	case "dig":
+		c.handleDigCommand(args)
 	default:
 		fmt.Printf("Unknown command: %s. Type 'help' for available commands.\n", cmd)
 	}
@@ -154,10 +173,212 @@ func (c *Console) handleLoadCommand(args []string) {
 		if regionData.StructureManager != nil {
 			c.sim.StructureManager = regionData.StructureManager
 		}
+		c.sim.UpdateRooms()
 		fmt.Printf("Region loaded successfully from %s!\n", filename)
 	}
 }
 
+// handleWeatherCommand forces the weather, e.g. "weather storm 300"
+func (c *Console) handleWeatherCommand(args []string) {
+	if len(args) == 0 {
+		fmt.Printf("Weather: %v (%d ticks left)\n", c.sim.Weather.Type, c.sim.Weather.RemainingTicks)
+		fmt.Println("Usage: weather <clear|rain|storm|drought|snow> [ticks]")
+		return
+	}
+
+	weatherType, ok := sim.ParseWeatherType(args[0])
+	if !ok {
+		fmt.Printf("Unknown weather: %s\n", args[0])
+		return
+	}
+
+	duration := config.WeatherMaxDuration
+	if len(args) > 1 {
+		ticks, err := strconv.Atoi(args[1])
+		if err != nil || ticks <= 0 {
+			fmt.Printf("Invalid duration: %s\n", args[1])
+			return
+		}
+		duration = ticks
+	}
+
+	c.sim.SetWeather(weatherType, duration, true)
+}
+
+// handleEventsCommand prints the last events, e.g. "events 20"
+func (c *Console) handleEventsCommand(args []string) {
+	count := 10
+	if len(args) > 0 {
+		if n, err := strconv.Atoi(args[0]); err == nil && n > 0 {
+			count = n
+		}
+	}
+	events := c.sim.Events
+	if len(events) > count {
+		events = events[len(events)-count:]
+	}
+	for _, event := range events {
+		fmt.Printf("[Year %d, Day %d, %02d:%02d] %s\n", event.Calendar.Year, event.Calendar.Day, event.Calendar.Hour, event.Calendar.Minute, event.Description)
+	}
+}
+
+// handleSpawnCommand generates new random colonists at the player position, e.g. "spawn 3"
+func (c *Console) handleSpawnCommand(args []string) {
+	count := 1
+	if len(args) > 0 {
+		n, err := strconv.Atoi(args[0])
+		if err != nil || n <= 0 {
+			fmt.Println("Usage: spawn [count]")
+			return
+		}
+		count = n
+	}
+	pos := sim.TilePosition{
+		X: int16(c.sim.Player.WorldPosition.X / config.TileSize),
+		Y: int16(c.sim.Player.WorldPosition.Y / config.TileSize),
+	}
+	if c.sim.GetTileAt(pos).MoveCost == sim.ImpassableCost {
+		fmt.Printf("Can't spawn on impassable tile (%d, %d)\n", pos.X, pos.Y)
+		return
+	}
+	for i := 0; i < count; i++ {
+		character := c.sim.GenerateCharacter(pos)
+		fmt.Printf("Spawned %v (%d): %v\n", character.Name, character.ID, character.Backstory)
+	}
+}
+
+// handleBuildCommand places a construction site at the player position, e.g. "build storage"
+func (c *Console) handleBuildCommand(args []string) {
+	if len(args) == 0 {
+		fmt.Println("Usage: build <well|bed|furniture|workshop|storage|fireplace>")
+		return
+	}
+	structureType, ok := sim.ParseStructureType(args[0])
+	if !ok {
+		fmt.Printf("Unknown structure: %s\n", args[0])
+		return
+	}
+	pos := sim.TilePosition{
+		X: int16(c.sim.Player.WorldPosition.X / config.TileSize),
+		Y: int16(c.sim.Player.WorldPosition.Y / config.TileSize),
+	}
+	if c.sim.GetTileAt(pos).Structure != -1 {
+		fmt.Printf("Tile (%d, %d) already has a structure\n", pos.X, pos.Y)
+		return
+	}
+	id := c.sim.PlaceConstructionSite(pos, structureType)
+	fmt.Printf("Placed %v construction site (ID: %d) at (%d, %d)\n", structureType, id, pos.X, pos.Y)
+}
+
+// handleBillCommand queues a recipe on the structure at the player position, e.g. "bill bread count 10" or "bill bread stock 20"
+// "bill remove <id>" removes a bill
+func (c *Console) handleBillCommand(args []string) {
+	if len(args) == 2 && args[0] == "remove" {
+		id, err := strconv.Atoi(args[1])
+		if err != nil || !c.sim.RemoveBill(int32(id)) {
+			fmt.Printf("Unknown bill: %s\n", args[1])
+			return
+		}
+		fmt.Printf("Removed bill %d\n", id)
+		return
+	}
+	if len(args) != 3 {
+		fmt.Println("Usage: bill <recipe> <count|stock> <n> or bill remove <id>")
+		return
+	}
+	recipe, ok := data.FindRecipeByName(args[0])
+	if !ok {
+		fmt.Printf("Unknown recipe: %s\n", args[0])
+		return
+	}
+	var mode sim.BillMode
+	switch strings.ToLower(args[1]) {
+	case "count":
+		mode = sim.BillCount
+	case "stock":
+		mode = sim.BillKeepStock
+	default:
+		fmt.Printf("Unknown bill mode: %s\n", args[1])
+		return
+	}
+	target, err := strconv.Atoi(args[2])
+	if err != nil || target <= 0 {
+		fmt.Printf("Invalid count: %s\n", args[2])
+		return
+	}
+	pos := sim.TilePosition{
+		X: int16(c.sim.Player.WorldPosition.X / config.TileSize),
+		Y: int16(c.sim.Player.WorldPosition.Y / config.TileSize),
+	}
+	structure := c.sim.GetStructureAt(pos)
+	if structure == nil {
+		fmt.Printf("No structure at (%d, %d)\n", pos.X, pos.Y)
+		return
+	}
+	id, err := c.sim.AddBill(structure, recipe.RecipeID, mode, target)
+	if err != nil {
+		fmt.Printf("Error adding bill: %v\n", err)
+		return
+	}
+	fmt.Printf("Added bill %d: %v %v %d\n", id, mode, recipe.Name, target)
+}
+
+// handleBillsCommand lists the bills of all structures
+func (c *Console) handleBillsCommand() {
+	c.sim.StructureManager.ForEach(func(id int, structure *sim.Structure) {
+		for _, bill := range structure.Bills {
+			fmt.Printf("[%d] %v at (%d, %d): %s\n", bill.ID, structure.StructureType, structure.Position.X, structure.Position.Y, c.sim.DescribeBill(&bill))
+		}
+	})
+}
+
+// handleChopCommand designates the trees around the player position to be chopped, e.g. "chop 5"
+func (c *Console) handleChopCommand(args []string) {
+	radius := 0
+	if len(args) > 0 {
+		n, err := strconv.Atoi(args[0])
+		if err != nil || n < 0 {
+			fmt.Println("Usage: chop [radius]")
+			return
+		}
+		radius = n
+	}
+	pos := sim.TilePosition{
+		X: int16(c.sim.Player.WorldPosition.X / config.TileSize),
+		Y: int16(c.sim.Player.WorldPosition.Y / config.TileSize),
+	}
+	count := c.sim.DesignateChop(pos, radius)
+	fmt.Printf("Designated %d trees to chop around (%d, %d)\n", count, pos.X, pos.Y)
+}
+
+// handleDigCommand designates the tiles around the player position for a terrain work, e.g. "dig mine 2" or "dig none 2" to cancel
+func (c *Console) handleDigCommand(args []string) {
+	if len(args) == 0 {
+		fmt.Println("Usage: dig <mine|floor|fill|till|none> [radius]")
+		return
+	}
+	work, ok := sim.ParseTerrainWork(args[0])
+	if !ok {
+		fmt.Printf("Unknown terrain work: %s\n", args[0])
+		return
+	}
+	radius := 0
+	if len(args) > 1 {
+		n, err := strconv.Atoi(args[1])
+		if err != nil || n < 0 {
+			fmt.Printf("Invalid radius: %s\n", args[1])
+			return
+		}
+		radius = n
+	}
+	pos := sim.TilePosition{
+		X: int16(c.sim.Player.WorldPosition.X / config.TileSize),
+		Y: int16(c.sim.Player.WorldPosition.Y / config.TileSize),
+	}
+	count := c.sim.DesignateTerrain(pos, radius, work)
+	fmt.Printf("Designated %d tiles to %v around (%d, %d)\n", count, work, pos.X, pos.Y)
+}
+
 // addToHistory adds a command to the history
 func (c *Console) addToHistory(command string) {
 	if command == "" {
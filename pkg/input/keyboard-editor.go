@@ -114,6 +114,10 @@ func (m *Manager) HandleKeyboardEditor(deltaTime float32) {
 			m.sim.UI.EditorStructureType = sim.Storage
 			fmt.Println("Editor structure type set to: Storage")
 		}
+		if rl.IsKeyPressed(rl.KeySix) {
+			m.sim.UI.EditorStructureType = sim.Fireplace
+			fmt.Println("Editor structure type set to: Fireplace")
+		}
 	}
 
 	// Handle WASD movement (works in all modes)
@@ -2,6 +2,7 @@ package input
 
 import (
 	"fmt"
+	"gociv/pkg/sim"
 
 	rl "github.com/gen2brain/raylib-go/raylib"
 )
@@ -19,6 +20,9 @@ func (m *Manager) HandleKeyboard(deltaTime float32) {
 		}
 	}
 
+	m.HandleScheduleEditor()
+	m.HandleWorkPriorities()
+
 	// Handle WASD movement
 	if rl.IsKeyDown(rl.KeyW) {
 		m.sim.Player.MoveUp(deltaTime)
@@ -33,3 +37,76 @@ func (m *Manager) HandleKeyboard(deltaTime float32) {
 		m.sim.Player.MoveRight(deltaTime)
 	}
 }
+
+// HandleScheduleEditor edits the schedule of the selected character
+// H toggles the editor, left/right select an hour, 1-3 set it to leisure/work/sleep, R resets the schedule
+func (m *Manager) HandleScheduleEditor() {
+	character := m.sim.GetCharacterByID(m.sim.UI.SelectedCharacterIndex)
+	if rl.IsKeyPressed(rl.KeyH) && character != nil {
+		m.sim.UI.ShowScheduleEditor = !m.sim.UI.ShowScheduleEditor
+		m.sim.UI.ShowWorkPriorities = false
+	}
+	if !m.sim.UI.ShowScheduleEditor || character == nil {
+		return
+	}
+
+	if rl.IsKeyPressed(rl.KeyLeft) {
+		m.sim.UI.ScheduleEditorHour = (m.sim.UI.ScheduleEditorHour + 23) % 24
+	}
+	if rl.IsKeyPressed(rl.KeyRight) {
+		m.sim.UI.ScheduleEditorHour = (m.sim.UI.ScheduleEditorHour + 1) % 24
+	}
+
+	hour := m.sim.UI.ScheduleEditorHour
+	if rl.IsKeyPressed(rl.KeyOne) {
+		character.Schedule[hour] = sim.ScheduleLeisure
+	}
+	if rl.IsKeyPressed(rl.KeyTwo) {
+		character.Schedule[hour] = sim.ScheduleWork
+	}
+	if rl.IsKeyPressed(rl.KeyThree) {
+		character.Schedule[hour] = sim.ScheduleSleep
+	}
+	if rl.IsKeyPressed(rl.KeyR) {
+		character.ResetSchedule()
+		fmt.Printf("Schedule of %v reset\n", character.Name)
+	}
+}
+
+// HandleWorkPriorities edits the work priorities of all characters
+// J toggles the panel, arrows select a cell, 0-4 set its priority (1 is the highest, 0 means never)
+func (m *Manager) HandleWorkPriorities() {
+	if rl.IsKeyPressed(rl.KeyJ) {
+		m.sim.UI.ShowWorkPriorities = !m.sim.UI.ShowWorkPriorities
+		m.sim.UI.ShowScheduleEditor = false
+	}
+	if !m.sim.UI.ShowWorkPriorities || len(m.sim.Characters) == 0 {
+		return
+	}
+
+	rows, columns := len(m.sim.Characters), len(sim.JobTypes)
+	if rl.IsKeyPressed(rl.KeyUp) {
+		m.sim.UI.WorkPrioritiesRow = (m.sim.UI.WorkPrioritiesRow + rows - 1) % rows
+	}
+	if rl.IsKeyPressed(rl.KeyDown) {
+		m.sim.UI.WorkPrioritiesRow = (m.sim.UI.WorkPrioritiesRow + 1) % rows
+	}
+	if rl.IsKeyPressed(rl.KeyLeft) {
+		m.sim.UI.WorkPrioritiesColumn = (m.sim.UI.WorkPrioritiesColumn + columns - 1) % columns
+	}
+	if rl.IsKeyPressed(rl.KeyRight) {
+		m.sim.UI.WorkPrioritiesColumn = (m.sim.UI.WorkPrioritiesColumn + 1) % columns
+	}
+	// characters can die while the panel is open
+	m.sim.UI.WorkPrioritiesRow = min(m.sim.UI.WorkPrioritiesRow, rows-1)
+
+	character := &m.sim.Characters[m.sim.UI.WorkPrioritiesRow]
+	jobType := sim.JobTypes[m.sim.UI.WorkPrioritiesColumn]
+	keys := []int32{rl.KeyZero, rl.KeyOne, rl.KeyTwo, rl.KeyThree, rl.KeyFour}
+	for priority, key := range keys {
+		if rl.IsKeyPressed(key) {
+			character.SetWorkPriority(jobType, uint8(priority))
+			fmt.Printf("%v %v priority set to %d\n", character.Name, jobType, priority)
+		}
+	}
+}
@@ -37,6 +37,11 @@ func (m *Manager) HandleInputs(deltaTime float32) {
 		m.sim.UI.EditMode = !m.sim.UI.EditMode
 	}
 
+	// F6 - Toggle reservations overlay
+	if rl.IsKeyPressed(rl.KeyF6) {
+		m.sim.UI.ShowReservations = !m.sim.UI.ShowReservations
+	}
+
 	// F5 - Save quicksave
 	if rl.IsKeyPressed(rl.KeyF5) {
 		err := utils.SaveSim(m.sim, "quicksave")
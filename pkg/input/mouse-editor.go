@@ -21,7 +21,7 @@ func (m *Manager) HandleMouseEditor(deltaTime float32) {
 		switch m.sim.UI.EditorMode {
 		case sim.EditorModeTiles:
 			fmt.Printf("Clicked tile position: (%d, %d)\n", tile.Position.X, tile.Position.Y)
-			tile.UpdateType(m.sim.UI.EditorTileType)
+			m.sim.ChangeTileType(tile, m.sim.UI.EditorTileType)
 
 		case sim.EditorModePlants:
 			// Check if tile already has a plant
@@ -63,7 +63,7 @@ func (m *Manager) HandleMouseEditor(deltaTime float32) {
 		switch m.sim.UI.EditorMode {
 		case sim.EditorModeTiles:
 			fmt.Printf("Right-clicked tile position: (%d, %d)\n", tile.Position.X, tile.Position.Y)
-			tile.UpdateType(sim.TileTypeEmpty)
+			m.sim.ChangeTileType(tile, sim.TileTypeEmpty)
 
 		case sim.EditorModePlants:
 			// Remove plant if present
@@ -1,6 +1,6 @@
 package input
 
-func (m *Manager) SelectCharacter(characterID int8) {
+func (m *Manager) SelectCharacter(characterID int16) {
 	m.sim.UI.SelectedCharacterIndex = characterID
 }
 
@@ -2,7 +2,11 @@ package render
 
 import (
 	"fmt"
+	"gociv/pkg/config"
+	"gociv/pkg/data"
 	"gociv/pkg/sim"
+	"sort"
+	"strings"
 
 	rl "github.com/gen2brain/raylib-go/raylib"
 )
@@ -19,7 +23,7 @@ func DrawCharacters(renderer *Renderer, characters []sim.Character) {
 
 // DrawCharacterDetails renders character info starting at (x, y) and returns
 // the updated y position after drawing.
-func DrawCharacterDetails(renderer *Renderer, character *sim.Character, x, y int) int {
+func DrawCharacterDetails(renderer *Renderer, simData *sim.Sim, character *sim.Character, x, y int) int {
 	if character == nil {
 		return y
 	}
@@ -35,6 +39,40 @@ func DrawCharacterDetails(renderer *Renderer, character *sim.Character, x, y int
 	// ID
 	renderer.RenderTextWithColor(fmt.Sprintf("ID: %d", character.ID), x, y, rl.NewColor(200, 200, 200, 255))
 	y += int(lineHeight)
+	renderer.RenderTextWithColor(fmt.Sprintf("Age: %d (%v, born day %d)", character.GetAge(simData.Calendar), character.LifeStage, character.BirthDay), x, y, rl.NewColor(200, 200, 200, 255))
+	y += int(lineHeight)
+
+	// Family
+	if character.PartnerID != -1 {
+		renderer.RenderTextWithColor(fmt.Sprintf("Partner: %s", simData.GetCharacterName(character.PartnerID)), x, y, rl.NewColor(200, 200, 200, 255))
+		y += int(lineHeight)
+	}
+	for _, parentID := range character.ParentIDs {
+		renderer.RenderTextWithColor(fmt.Sprintf("Parent: %s", simData.GetCharacterName(parentID)), x, y, rl.NewColor(200, 200, 200, 255))
+		y += int(lineHeight)
+	}
+	for _, childID := range character.ChildrenIDs {
+		renderer.RenderTextWithColor(fmt.Sprintf("Child: %s", simData.GetCharacterName(childID)), x, y, rl.NewColor(200, 200, 200, 255))
+		y += int(lineHeight)
+	}
+
+	// Traits
+	if len(character.Traits) > 0 {
+		renderer.RenderTextWithColor("Traits:", x, y, rl.NewColor(255, 255, 255, 255))
+		y += int(lineHeight)
+		for _, trait := range character.Traits {
+			description := ""
+			if def, ok := data.GetTraitDefinition(int(trait)); ok {
+				description = def.Description
+			}
+			renderer.RenderTextWithColor(fmt.Sprintf("  %v: %s", trait, description), x, y, rl.NewColor(200, 200, 200, 255))
+			y += int(lineHeight)
+		}
+	}
+	for _, line := range wrapText(character.Backstory, 40) {
+		renderer.RenderTextWithColor(line, x, y, rl.NewColor(150, 150, 150, 255))
+		y += int(lineHeight)
+	}
 
 	// Position
 	renderer.RenderTextWithColor("Position:", x, y, rl.NewColor(255, 255, 255, 255))
@@ -47,12 +85,92 @@ func DrawCharacterDetails(renderer *Renderer, character *sim.Character, x, y int
 	// Needs
 	renderer.RenderTextWithColor("Needs:", x, y, rl.NewColor(255, 255, 255, 255))
 	y += int(lineHeight)
-	renderer.RenderTextWithColor(fmt.Sprintf("  Food: %d", character.Needs.Food), x, y, rl.NewColor(200, 200, 200, 255))
+	for _, def := range data.NeedDefinitions {
+		needType := sim.NeedType(def.NeedType)
+		color := rl.NewColor(200, 200, 200, 255)
+		if character.IsNeedCritical(needType) {
+			color = rl.NewColor(220, 120, 120, 255)
+		}
+		renderer.RenderTextWithColor(fmt.Sprintf("  %s: %.0f", def.Name, character.GetNeed(needType)), x, y, color)
+		y += int(lineHeight)
+	}
+
+	// Health
+	renderer.RenderTextWithColor(fmt.Sprintf("Health: %d", character.Health), x, y, rl.NewColor(255, 255, 255, 255))
+	y += int(lineHeight)
+	for _, injury := range character.Injuries {
+		renderer.RenderTextWithColor(fmt.Sprintf("  %v (%d)", injury.Cause, injury.Severity), x, y, rl.NewColor(220, 120, 120, 255))
+		y += int(lineHeight)
+	}
+
+	// Mood
+	moodColor := rl.NewColor(255, 255, 255, 255)
+	if character.HasLowMood() {
+		moodColor = rl.NewColor(220, 120, 120, 255)
+	}
+	renderer.RenderTextWithColor(fmt.Sprintf("Mood: %.0f", character.Mood), x, y, moodColor)
+	y += int(lineHeight)
+	for _, modifier := range simData.GetMoodModifiers(character) {
+		color := rl.NewColor(120, 200, 120, 255)
+		if modifier.Value < 0 {
+			color = rl.NewColor(220, 120, 120, 255)
+		}
+		renderer.RenderTextWithColor(fmt.Sprintf("  %s %+.0f", modifier.Label, modifier.Value), x, y, color)
+		y += int(lineHeight)
+	}
+
+	// Relationships
+	if len(character.Opinions) > 0 {
+		renderer.RenderTextWithColor("Relationships:", x, y, rl.NewColor(255, 255, 255, 255))
+		y += int(lineHeight)
+		ids := make([]int16, 0, len(character.Opinions))
+		for id := range character.Opinions {
+			ids = append(ids, id)
+		}
+		sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+		for _, id := range ids {
+			color := rl.NewColor(200, 200, 200, 255)
+			if opinion := character.GetOpinion(id); opinion >= config.FriendOpinion {
+				color = rl.NewColor(120, 200, 120, 255)
+			} else if opinion <= config.RivalOpinion {
+				color = rl.NewColor(220, 120, 120, 255)
+			}
+			renderer.RenderTextWithColor(fmt.Sprintf("  %s: %+.0f (%s)", simData.GetCharacterName(id), character.GetOpinion(id), character.GetRelationshipLabel(id)), x, y, color)
+			y += int(lineHeight)
+		}
+	}
+
+	// Schedule
+	block := character.GetScheduleBlock(simData.Calendar.Hour)
+	renderer.RenderTextWithColor(fmt.Sprintf("Schedule: %v (H to edit)", block), x, y, rl.NewColor(255, 255, 255, 255))
 	y += int(lineHeight)
-	renderer.RenderTextWithColor(fmt.Sprintf("  Water: %d", character.Needs.Water), x, y, rl.NewColor(200, 200, 200, 255))
+
+	// Skills
+	renderer.RenderTextWithColor("Skills:", x, y, rl.NewColor(255, 255, 255, 255))
 	y += int(lineHeight)
-	renderer.RenderTextWithColor(fmt.Sprintf("  Sleep: %d", character.Needs.Sleep), x, y, rl.NewColor(200, 200, 200, 255))
+	for _, skillType := range sim.Skills {
+		skill := character.Skills[skillType]
+		renderer.RenderTextWithColor(fmt.Sprintf("  %v: %d (%.0f/%.0f xp)", skillType, skill.Level, skill.Experience, sim.GetExperienceForNextLevel(skill.Level)), x, y, rl.NewColor(200, 200, 200, 255))
+		y += int(lineHeight)
+	}
+
+	// Inventory
+	weight, volume := simData.GetCarriedLoad(character)
+	maxWeight, maxVolume := simData.GetCarryCapacity(character)
+	renderer.RenderTextWithColor(fmt.Sprintf("Carrying: %.1f/%.0f kg, %.1f/%.0f L", weight, maxWeight, volume, maxVolume), x, y, rl.NewColor(255, 255, 255, 255))
 	y += int(lineHeight)
+	for _, itemID := range character.Inventory {
+		if item := simData.GetItemPtr(itemID); item != nil {
+			renderer.RenderTextWithColor("  "+sim.GetItemName(item), x, y, rl.NewColor(200, 200, 200, 255))
+			y += int(lineHeight)
+		}
+	}
+	for _, slot := range sim.EquipmentSlots {
+		if item := simData.GetEquippedItem(character, slot); item != nil {
+			renderer.RenderTextWithColor(fmt.Sprintf("  %v: %s", slot, sim.GetItemName(item)), x, y, rl.NewColor(200, 200, 200, 255))
+			y += int(lineHeight)
+		}
+	}
 
 	// Current Task
 	renderer.RenderTextWithColor("Current Task:", x, y, rl.NewColor(255, 255, 255, 255))
@@ -87,10 +205,26 @@ func DrawCharacterDetails(renderer *Renderer, character *sim.Character, x, y int
 			if objective.Stuck {
 				stuckStr = " [STUCK]"
 			}
-			renderer.RenderTextWithColor(fmt.Sprintf("  %d. %s%s", i+1, objTypeStr, stuckStr), x, y, rl.NewColor(200, 200, 200, 255))
+			renderer.RenderTextWithColor(fmt.Sprintf("  %d. %s (score %.0f)%s", i+1, objTypeStr, objective.Score, stuckStr), x, y, rl.NewColor(200, 200, 200, 255))
 			y += int(lineHeight)
+			if len(objective.Actions) > 0 {
+				actions := make([]string, len(objective.Actions))
+				for j, action := range objective.Actions {
+					actions[j] = action.String()
+				}
+				renderer.RenderTextWithColor(fmt.Sprintf("     Strategy: %s", strings.Join(actions, " > ")), x, y, rl.NewColor(150, 150, 150, 255))
+				y += int(lineHeight)
+			}
+			if objective.Suspended != nil {
+				renderer.RenderTextWithColor(fmt.Sprintf("     Suspended: %v %.0f%%", objective.Suspended.Type, objective.Suspended.Progress), x, y, rl.NewColor(150, 150, 150, 255))
+				y += int(lineHeight)
+			}
 			if len(objective.Plan) > 0 {
-				renderer.RenderTextWithColor(fmt.Sprintf("     Plan: %d tasks", len(objective.Plan)), x, y, rl.NewColor(150, 150, 150, 255))
+				steps := make([]string, len(objective.Plan))
+				for j, step := range objective.Plan {
+					steps[j] = step.Type.String()
+				}
+				renderer.RenderTextWithColor(fmt.Sprintf("     Next: %s", strings.Join(steps, " > ")), x, y, rl.NewColor(150, 150, 150, 255))
 				y += int(lineHeight)
 			}
 		}
@@ -105,7 +239,11 @@ func DrawCharacterDetails(renderer *Renderer, character *sim.Character, x, y int
 		renderer.RenderTextWithColor("Ambitions:", x, y, rl.NewColor(255, 255, 255, 255))
 		y += int(lineHeight)
 		for i, ambition := range character.Ambitions {
-			renderer.RenderTextWithColor(fmt.Sprintf("  %d. %s", i+1, ambition.Description), x, y, rl.NewColor(200, 200, 200, 255))
+			status := fmt.Sprintf("%.0f%%", ambition.Progress*100)
+			if ambition.Completed {
+				status = "done"
+			}
+			renderer.RenderTextWithColor(fmt.Sprintf("  %d. %s (%s)", i+1, ambition.Description, status), x, y, rl.NewColor(200, 200, 200, 255))
 			y += int(lineHeight)
 		}
 		y += int(lineHeight)
@@ -139,3 +277,23 @@ func DrawCharacterDetails(renderer *Renderer, character *sim.Character, x, y int
 
 	return y
 }
+
+// wrapText splits a text into lines of at most maxChars characters, breaking on spaces
+func wrapText(text string, maxChars int) []string {
+	var lines []string
+	line := ""
+	for _, word := range strings.Fields(text) {
+		if line != "" && len(line)+1+len(word) > maxChars {
+			lines = append(lines, line)
+			line = ""
+		}
+		if line != "" {
+			line += " "
+		}
+		line += word
+	}
+	if line != "" {
+		lines = append(lines, line)
+	}
+	return lines
+}
@@ -19,8 +19,9 @@ var (
 	ColorStructure  = rl.Color{R: 230, G: 230, B: 230, A: 255}
 
 	// Debug or Editor colors
-	ColorEditMode = rl.Color{R: 255, G: 255, B: 0, A: 255} // Yellow for EditMode indicator
-	ColorPath     = rl.Color{R: 255, G: 165, B: 0, A: 180} // Orange with transparency for path highlighting
+	ColorEditMode    = rl.Color{R: 255, G: 255, B: 0, A: 255}  // Yellow for EditMode indicator
+	ColorPath        = rl.Color{R: 255, G: 165, B: 0, A: 180}  // Orange with transparency for path highlighting
+	ColorDesignation = rl.Color{R: 240, G: 200, B: 90, A: 200} // tiles designated for a terrain work
 )
 
 // TileTypeColors maps each TileType to its corresponding color
@@ -33,6 +34,7 @@ var TileTypeColors = map[sim.TileType]rl.Color{
 }
 
 var ItemTypeColors = map[sim.ItemType]rl.Color{
-	sim.ItemTypeFood: ColorLife,
-	sim.ItemTypeSeed: ColorStructure,
+	sim.ItemTypeFood:     ColorLife,
+	sim.ItemTypeSeed:     ColorStructure,
+	sim.ItemTypeMaterial: ColorDirt,
 }
@@ -133,7 +133,7 @@ func DrawEditorUI(renderer *Renderer, simData *sim.Sim) {
 		rl.DrawTextEx(font, valueText, rl.Vector2{X: float32(valueX), Y: float32(yPos)}, fontSize, 1.0, ColorEditorValue)
 		yPos += int32(fontSize) + 8
 
-		helpText := "Keys: 1-5 to select structure type"
+		helpText := "Keys: 1-6 to select structure type"
 		rl.DrawTextEx(font, helpText, rl.Vector2{X: float32(textX), Y: float32(yPos)}, fontSize*0.85, 1.0, ColorEditorLabel)
 		yPos += int32(fontSize*0.85) + 8
 	}
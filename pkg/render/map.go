@@ -112,6 +112,9 @@ func DrawTile(renderer *Renderer, simData *sim.Sim, tile sim.Tile) {
 		config.TileSize,
 		TileTypeColors[tile.Type],
 	)
+	if tile.Designation != sim.TerrainNone {
+		rl.DrawRectangleLinesEx(rl.Rectangle{X: float32(tile.Position.X) * config.TileSize, Y: float32(tile.Position.Y) * config.TileSize, Width: config.TileSize, Height: config.TileSize}, 1, ColorDesignation)
+	}
 	// items
 	for i, itemID := range tile.Items {
 		baseX := float32(tile.Position.X * config.TileSize)
@@ -12,7 +12,8 @@ import (
 func DrawPlant(renderer *Renderer, plant sim.Plant) {
 	centerX := float32(plant.Position.X*config.TileSize + config.TileSize/2)
 	centerY := float32(plant.Position.Y*config.TileSize + config.TileSize/2)
-	size := float32(10)
+	// seedlings are drawn smaller
+	size := float32(4) + float32(plant.GrowthStage)*6/100
 
 	// Create an upward-pointing triangle
 	v1 := rl.Vector2{X: centerX, Y: centerY - size}        // Top vertex
@@ -24,7 +25,7 @@ func DrawPlant(renderer *Renderer, plant sim.Plant) {
 
 // DrawPlantDetails renders plant info starting at (x, y) and returns
 // the updated y position after drawing.
-func DrawPlantDetails(renderer *Renderer, plant *sim.Plant, x, y int) int {
+func DrawPlantDetails(renderer *Renderer, simData *sim.Sim, plant *sim.Plant, x, y int) int {
 	if plant == nil {
 		return y
 	}
@@ -61,6 +62,16 @@ func DrawPlantDetails(renderer *Renderer, plant *sim.Plant, x, y int) int {
 	y += int(lineHeight)
 	renderer.RenderTextWithColor(fmt.Sprintf("  Rate: %d / update", plant.GrowthRate), x, y, rl.NewColor(200, 200, 200, 255))
 	y += int(lineHeight)
+	renderer.RenderTextWithColor(fmt.Sprintf("  Age: %d days", plant.Age), x, y, rl.NewColor(200, 200, 200, 255))
+	y += int(lineHeight)
+	if simData.IsPlantDormant(plant) {
+		renderer.RenderTextWithColor("  Dormant", x, y, rl.NewColor(200, 200, 200, 255))
+		y += int(lineHeight)
+	}
+	if plant.ChopDesignated {
+		renderer.RenderTextWithColor(fmt.Sprintf("  To chop: %d wood", sim.GetChopYield(plant)), x, y, rl.NewColor(200, 200, 200, 255))
+		y += int(lineHeight)
+	}
 
 	// Production
 	if plant.Produces.ProductionRate > 0 {
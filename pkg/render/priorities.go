@@ -0,0 +1,60 @@
+package render
+
+import (
+	"fmt"
+	"gociv/pkg/sim"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// DrawWorkPriorities renders the grid of work priorities, one row per character and one column per job type
+func DrawWorkPriorities(renderer *Renderer, simData *sim.Sim) {
+	if !simData.UI.ShowWorkPriorities {
+		return
+	}
+
+	lineHeight := int32(renderer.DefaultFont.BaseSize + 6)
+	nameWidth := int32(120)
+	cellWidth := int32(70)
+	padding := int32(10)
+	rows := int32(len(simData.Characters))
+	panelWidth := nameWidth + cellWidth*int32(len(sim.JobTypes)) + padding*2
+	panelHeight := lineHeight*(rows+4) + padding*2
+	panelX := int32(10)
+	panelY := int32(rl.GetScreenHeight()) - panelHeight - 10
+
+	rl.DrawRectangle(panelX, panelY, panelWidth, panelHeight, ColorEditorBackground)
+	rl.DrawRectangleLines(panelX, panelY, panelWidth, panelHeight, ColorBorder)
+
+	x := panelX + padding
+	y := panelY + padding
+	renderer.RenderTextWithColor(fmt.Sprintf("Work priorities - %d jobs posted", len(simData.Jobs)), int(x), int(y), ColorEditorTitle)
+	y += lineHeight
+
+	for column, jobType := range sim.JobTypes {
+		renderer.RenderTextWithColor(jobType.String(), int(x+nameWidth+int32(column)*cellWidth), int(y), ColorEditorLabel)
+	}
+	y += lineHeight
+
+	for row := range simData.Characters {
+		character := &simData.Characters[row]
+		renderer.RenderTextWithColor(character.Name, int(x), int(y), ColorEditorLabel)
+		for column, jobType := range sim.JobTypes {
+			cellX := x + nameWidth + int32(column)*cellWidth
+			text := "-"
+			if priority := character.GetWorkPriority(jobType); priority > 0 {
+				text = fmt.Sprintf("%d", priority)
+			}
+			renderer.RenderTextWithColor(text, int(cellX), int(y), rl.NewColor(200, 200, 200, 255))
+			if row == simData.UI.WorkPrioritiesRow && column == simData.UI.WorkPrioritiesColumn {
+				rl.DrawRectangleLines(cellX-4, y-2, cellWidth-8, lineHeight, ColorEditorTitle)
+			}
+		}
+		y += lineHeight
+	}
+	y += lineHeight / 2
+
+	renderer.RenderTextWithColor("1: highest  4: lowest  0: never", int(x), int(y), ColorEditorLabel)
+	y += lineHeight
+	renderer.RenderTextWithColor("Arrows: select  J: close", int(x), int(y), ColorEditorLabel)
+}
@@ -39,15 +39,18 @@ func (r *Renderer) Render(simData *sim.Sim) {
 	DrawMap(r, simData)
 	DrawPlayer(r, simData.Player)
 	DrawCharacters(r, simData.Characters)
+	DrawReservations(r, simData)
 	rl.EndMode2D()
 
+	DrawWeatherOverlay(r, simData.Weather)
+
 	// Draw UI elements (outside of 2D mode)
 	r.DrawUI(simData)
 }
 
 // DrawUI renders UI elements like EditMode indicator
 func (r *Renderer) DrawUI(simData *sim.Sim) {
-	DisplayTime(r, &simData.Calendar)
+	DisplayTime(r, &simData.Calendar, &simData.Weather)
 
 	// Draw editor UI panel
 	DrawEditorUI(r, simData)
@@ -55,6 +58,9 @@ func (r *Renderer) DrawUI(simData *sim.Sim) {
 	// Unified side panel with stacked tile/character/plant details
 	DrawSidePanel(r, simData)
 
+	DrawScheduleEditor(r, simData)
+	DrawWorkPriorities(r, simData)
+
 	// Draw console if open
 	if r.Console != nil && r.Console.IsOpen() {
 		DrawConsole(r.Console)
@@ -0,0 +1,34 @@
+package render
+
+import (
+	"fmt"
+	"gociv/pkg/config"
+	"gociv/pkg/sim"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+var ReservationTypeColors = map[sim.ReservationType]rl.Color{
+	sim.ReserveItem:      rl.Color{R: 255, G: 165, B: 0, A: 220},
+	sim.ReserveStructure: rl.Color{R: 120, G: 200, B: 255, A: 220},
+	sim.ReserveTile:      rl.Color{R: 150, G: 230, B: 120, A: 220},
+}
+
+// DrawReservations outlines reserved tiles with the ID of the character holding them, debug overlay toggled with F6
+func DrawReservations(renderer *Renderer, simData *sim.Sim) {
+	if !simData.UI.ShowReservations {
+		return
+	}
+	for i := range simData.Reservations.Reservations {
+		reservation := &simData.Reservations.Reservations[i]
+		position, ok := simData.GetReservationPosition(reservation)
+		if !ok {
+			continue
+		}
+		color := ReservationTypeColors[reservation.Type]
+		x := float32(position.X) * config.TileSize
+		y := float32(position.Y) * config.TileSize
+		rl.DrawRectangleLinesEx(rl.Rectangle{X: x + 2, Y: y + 2, Width: config.TileSize - 4, Height: config.TileSize - 4}, 2, color)
+		rl.DrawText(fmt.Sprintf("%d", reservation.CharacterID), int32(x)+4, int32(y)+4, 10, color)
+	}
+}
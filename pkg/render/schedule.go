@@ -0,0 +1,62 @@
+package render
+
+import (
+	"fmt"
+	"gociv/pkg/sim"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+var ScheduleBlockColors = map[sim.ScheduleBlock]rl.Color{
+	sim.ScheduleLeisure: rl.Color{R: 72, G: 140, B: 84, A: 255},
+	sim.ScheduleWork:    rl.Color{R: 214, G: 150, B: 83, A: 255},
+	sim.ScheduleSleep:   rl.Color{R: 70, G: 90, B: 160, A: 255},
+}
+
+// DrawScheduleEditor renders the daily schedule of the selected character at the bottom of the screen
+func DrawScheduleEditor(renderer *Renderer, simData *sim.Sim) {
+	if !simData.UI.ShowScheduleEditor {
+		return
+	}
+	character := simData.GetCharacterByID(simData.UI.SelectedCharacterIndex)
+	if character == nil {
+		return
+	}
+
+	lineHeight := int32(renderer.DefaultFont.BaseSize + 6)
+	cellSize := int32(20)
+	padding := int32(10)
+	panelWidth := cellSize*24 + padding*2
+	panelHeight := lineHeight*4 + cellSize + padding*2
+	panelX := int32(10)
+	panelY := int32(rl.GetScreenHeight()) - panelHeight - 10
+
+	rl.DrawRectangle(panelX, panelY, panelWidth, panelHeight, ColorEditorBackground)
+	rl.DrawRectangleLines(panelX, panelY, panelWidth, panelHeight, ColorBorder)
+
+	x := panelX + padding
+	y := panelY + padding
+	hour := simData.UI.ScheduleEditorHour
+	renderer.RenderTextWithColor(fmt.Sprintf("Schedule of %s - %02d:00 %v", character.Name, hour, character.Schedule[hour]), int(x), int(y), ColorEditorTitle)
+	y += lineHeight
+
+	for h := int32(0); h < 24; h++ {
+		cellX := x + h*cellSize
+		rl.DrawRectangle(cellX, y, cellSize-2, cellSize, ScheduleBlockColors[character.Schedule[h]])
+		if int8(h) == simData.Calendar.Hour {
+			rl.DrawRectangle(cellX, y+cellSize-4, cellSize-2, 4, rl.White)
+		}
+		if int8(h) == hour {
+			rl.DrawRectangleLines(cellX-1, y-1, cellSize, cellSize+2, ColorEditorTitle)
+		}
+	}
+	y += cellSize + 4
+	for h := int32(0); h < 24; h += 6 {
+		renderer.RenderTextWithColor(fmt.Sprintf("%d", h), int(x+h*cellSize), int(y), ColorEditorLabel)
+	}
+	y += lineHeight
+
+	renderer.RenderTextWithColor("1: Leisure  2: Work  3: Sleep", int(x), int(y), ColorEditorLabel)
+	y += lineHeight
+	renderer.RenderTextWithColor("Left/Right: hour  R: reset  H: close", int(x), int(y), ColorEditorLabel)
+}
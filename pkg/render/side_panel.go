@@ -15,8 +15,7 @@ func DrawSidePanel(renderer *Renderer, simData *sim.Sim) {
 		simData.UI.SelectedTileIndex >= 0 &&
 		simData.UI.SelectedTileIndex < len(simData.Tiles)
 	hasCharacter := simData.UI.SelectedCharacterIndex != -1 &&
-		simData.UI.SelectedCharacterIndex >= 0 &&
-		simData.UI.SelectedCharacterIndex < int8(len(simData.Characters))
+		simData.GetCharacterByID(simData.UI.SelectedCharacterIndex) != nil
 	hasPlant := simData.UI.SelectedPlantIndex != -1 && simData.PlantManager != nil
 	hasStructure := simData.UI.SelectedStructureIndex != -1 && simData.StructureManager != nil
 
@@ -77,9 +76,9 @@ func DrawSidePanel(renderer *Renderer, simData *sim.Sim) {
 
 	// Character details
 	if hasCharacter {
-		character := &simData.Characters[simData.UI.SelectedCharacterIndex]
+		character := simData.GetCharacterByID(simData.UI.SelectedCharacterIndex)
 		drawSectionSeparator()
-		y = DrawCharacterDetails(renderer, character, x, y)
+		y = DrawCharacterDetails(renderer, simData, character, x, y)
 
 		y += int(lineHeight) // Extra spacing after section
 	}
@@ -89,7 +88,7 @@ func DrawSidePanel(renderer *Renderer, simData *sim.Sim) {
 		plant := simData.GetPlantByID(simData.UI.SelectedPlantIndex)
 		if plant != nil {
 			drawSectionSeparator()
-			y = DrawPlantDetails(renderer, plant, x, y)
+			y = DrawPlantDetails(renderer, simData, plant, x, y)
 		}
 	}
 
@@ -98,7 +97,7 @@ func DrawSidePanel(renderer *Renderer, simData *sim.Sim) {
 		structure := simData.GetStructurePtrByID(simData.UI.SelectedStructureIndex)
 		if structure != nil {
 			drawSectionSeparator()
-			y = DrawStructureDetails(renderer, structure, x, y)
+			y = DrawStructureDetails(renderer, simData, structure, x, y)
 		}
 	}
 }
@@ -130,12 +129,32 @@ func DrawTileDetails(renderer *Renderer, simData *sim.Sim, tile *sim.Tile, x, y
 	)
 	y += int(lineHeight)
 
+	renderer.RenderTextWithColor(
+		fmt.Sprintf("Temperature: %.1f C", simData.GetTileTemperature(tile.Position)),
+		x, y, rl.NewColor(200, 200, 200, 255),
+	)
+	y += int(lineHeight)
+
+	if tile.Designation != sim.TerrainNone {
+		renderer.RenderTextWithColor(
+			fmt.Sprintf("Designated: %v", tile.Designation),
+			x, y, rl.NewColor(200, 200, 200, 255),
+		)
+		y += int(lineHeight)
+	}
+
 	if len(tile.Items) > 0 {
 		renderer.RenderTextWithColor(
 			fmt.Sprintf("Items on tile: %d", len(tile.Items)),
 			x, y, rl.NewColor(200, 200, 200, 255),
 		)
 		y += int(lineHeight)
+		for _, itemID := range tile.Items {
+			if item := simData.GetItemPtr(itemID); item != nil {
+				renderer.RenderTextWithColor("  "+sim.GetItemName(item), x, y, rl.NewColor(200, 200, 200, 255))
+				y += int(lineHeight)
+			}
+		}
 	}
 
 	// Zone information
@@ -3,6 +3,7 @@ package render
 import (
 	"fmt"
 	"gociv/pkg/config"
+	"gociv/pkg/data"
 	"gociv/pkg/sim"
 
 	rl "github.com/gen2brain/raylib-go/raylib"
@@ -15,6 +16,7 @@ var StructureTypeColors = map[sim.StructureType]rl.Color{
 	sim.Furniture: {R: 150, G: 150, B: 150, A: 255}, // Gray
 	sim.Workshop:  {R: 255, G: 165, B: 0, A: 255},   // Orange (work/activity)
 	sim.Storage:   {R: 72, G: 150, B: 72, A: 255},   // Green (storage/containers)
+	sim.Fireplace: {R: 230, G: 90, B: 40, A: 255},   // Red-orange (fire)
 }
 
 // structureTypeString converts StructureType to a readable string
@@ -30,6 +32,8 @@ func structureTypeString(st sim.StructureType) string {
 		return "Workshop"
 	case sim.Storage:
 		return "Storage"
+	case sim.Fireplace:
+		return "Fireplace"
 	default:
 		return fmt.Sprintf("Unknown (%d)", int(st))
 	}
@@ -72,6 +76,10 @@ func DrawStructure(renderer *Renderer, structure sim.Structure) {
 		// Storage: Rectangle with border (box-like)
 		rl.DrawRectangle(int32(centerX-halfSize), int32(centerY-halfSize), int32(size), int32(size), color)
 		rl.DrawRectangleLines(int32(centerX-halfSize), int32(centerY-halfSize), int32(size), int32(size), rl.Color{R: color.R - 30, G: color.G - 30, B: color.B - 30, A: 255})
+	case sim.Fireplace:
+		// Fireplace: flame-like circle with a glowing core
+		rl.DrawCircle(int32(centerX), int32(centerY), halfSize+2, color)
+		rl.DrawCircle(int32(centerX), int32(centerY), halfSize/2, rl.Color{R: 255, G: 210, B: 90, A: 255})
 	default:
 		// Default: Rectangle
 		rl.DrawRectangle(int32(centerX-halfSize), int32(centerY-halfSize), int32(size), int32(size), color)
@@ -80,7 +88,7 @@ func DrawStructure(renderer *Renderer, structure sim.Structure) {
 
 // DrawStructureDetails renders structure info starting at (x, y) and returns
 // the updated y position after drawing.
-func DrawStructureDetails(renderer *Renderer, structure *sim.Structure, x, y int) int {
+func DrawStructureDetails(renderer *Renderer, simData *sim.Sim, structure *sim.Structure, x, y int) int {
 	if structure == nil {
 		return y
 	}
@@ -117,6 +125,15 @@ func DrawStructureDetails(renderer *Renderer, structure *sim.Structure, x, y int
 		y += int(lineHeight)
 		renderer.RenderTextWithColor(fmt.Sprintf("  %d%%", structure.BuildProgress), x, y, rl.NewColor(200, 200, 200, 255))
 		y += int(lineHeight)
+		if structure.MaterialsNeeded > 0 {
+			itemType, variant, _ := sim.GetStructureCost(structure.StructureType)
+			name := "material"
+			if def, ok := data.GetItemDefinition(int(itemType), variant); ok {
+				name = def.Name
+			}
+			renderer.RenderTextWithColor(fmt.Sprintf("  Needs %d %s", structure.MaterialsNeeded, name), x, y, rl.NewColor(200, 200, 200, 255))
+			y += int(lineHeight)
+		}
 	}
 
 	// Owner
@@ -129,5 +146,15 @@ func DrawStructureDetails(renderer *Renderer, structure *sim.Structure, x, y int
 	}
 	y += int(lineHeight)
 
+	// Bills
+	if len(structure.Bills) > 0 {
+		renderer.RenderTextWithColor("Bills:", x, y, rl.NewColor(255, 255, 255, 255))
+		y += int(lineHeight)
+		for i := range structure.Bills {
+			renderer.RenderTextWithColor("  "+simData.DescribeBill(&structure.Bills[i]), x, y, rl.NewColor(200, 200, 200, 255))
+			y += int(lineHeight)
+		}
+	}
+
 	return y
 }
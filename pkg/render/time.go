@@ -7,13 +7,18 @@ import (
 	rl "github.com/gen2brain/raylib-go/raylib"
 )
 
-// DisplayTime shows the current time
-func DisplayTime(r *Renderer, calendar *sim.Calendar) {
+// DisplayTime shows the current time, season and weather
+func DisplayTime(r *Renderer, calendar *sim.Calendar, weather *sim.Weather) {
 	timeText := fmt.Sprintf("Day %d, Hour %d, Minute %d", calendar.Day, calendar.Hour, calendar.Minute)
+	seasonText := fmt.Sprintf("Year %d, %v - %v", calendar.Year, calendar.Season(), weather.Type)
+	if weather.Forced {
+		seasonText += " (forced)"
+	}
 
 	// Draw white background
-	rl.DrawRectangle(8, 8, 185, 24, rl.White)
+	rl.DrawRectangle(8, 8, 220, 46, rl.White)
 
 	// Draw text
 	r.RenderTextWithColor(timeText, 20, 13, rl.Black)
+	r.RenderTextWithColor(seasonText, 20, 33, rl.Black)
 }
@@ -0,0 +1,58 @@
+package render
+
+import (
+	"gociv/pkg/sim"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// WeatherTints maps each WeatherType to the color overlaid on the screen
+var WeatherTints = map[sim.WeatherType]rl.Color{
+	sim.Clear:   {R: 0, G: 0, B: 0, A: 0},
+	sim.Rain:    {R: 40, G: 60, B: 110, A: 50},
+	sim.Storm:   {R: 10, G: 15, B: 40, A: 110},
+	sim.Drought: {R: 220, G: 150, B: 40, A: 40},
+	sim.Snow:    {R: 230, G: 235, B: 245, A: 60},
+}
+
+// DrawWeatherOverlay tints the screen and draws falling rain or snow
+func DrawWeatherOverlay(renderer *Renderer, weather sim.Weather) {
+	screenWidth := int32(rl.GetScreenWidth())
+	screenHeight := int32(rl.GetScreenHeight())
+
+	tint := WeatherTints[weather.Type]
+	if tint.A > 0 {
+		rl.DrawRectangle(0, 0, screenWidth, screenHeight, tint)
+	}
+
+	time := float32(rl.GetTime())
+	switch weather.Type {
+	case sim.Rain, sim.Storm:
+		drops := 150
+		if weather.Type == sim.Storm {
+			drops = 300
+		}
+		dropColor := rl.Color{R: 160, G: 190, B: 230, A: 140}
+		for i := 0; i < drops; i++ {
+			// spread drops with a cheap hash so they don't move in lockstep
+			x := float32((i*7919)%int(screenWidth)) + time*60
+			y := float32((i*104729)%int(screenHeight)) + time*700
+			x = float32(int(x) % int(screenWidth))
+			y = float32(int(y) % int(screenHeight))
+			rl.DrawLineEx(rl.Vector2{X: x, Y: y}, rl.Vector2{X: x + 3, Y: y + 14}, 1.0, dropColor)
+		}
+		// occasional lightning flash during storms
+		if weather.Type == sim.Storm && int(time*10)%97 == 0 {
+			rl.DrawRectangle(0, 0, screenWidth, screenHeight, rl.Color{R: 255, G: 255, B: 255, A: 90})
+		}
+	case sim.Snow:
+		flakeColor := rl.Color{R: 255, G: 255, B: 255, A: 200}
+		for i := 0; i < 200; i++ {
+			x := float32((i*7919)%int(screenWidth)) + time*20
+			y := float32((i*104729)%int(screenHeight)) + time*60
+			x = float32(int(x) % int(screenWidth))
+			y = float32(int(y) % int(screenHeight))
+			rl.DrawCircleV(rl.Vector2{X: x, Y: y}, 2, flakeColor)
+		}
+	}
+}
@@ -0,0 +1,178 @@
+package sim
+
+import (
+	"fmt"
+	"gociv/pkg/config"
+)
+
+type LifeStage int
+
+const (
+	Child LifeStage = iota
+	Adult
+	Elder
+)
+
+func (ls LifeStage) String() string {
+	switch ls {
+	case Child:
+		return "Child"
+	case Adult:
+		return "Adult"
+	case Elder:
+		return "Elder"
+	default:
+		return "Unknown"
+	}
+}
+
+// GetLifeStageForAge returns the life stage of a character of a given age in years
+func GetLifeStageForAge(age int) LifeStage {
+	if age >= config.ElderAge {
+		return Elder
+	}
+	if age >= config.AdultAge {
+		return Adult
+	}
+	return Child
+}
+
+// GetAge returns the age of the character in years at a given date
+func (character *Character) GetAge(calendar Calendar) int {
+	age := int(calendar.Year - character.BirthYear)
+	if calendar.Day < character.BirthDay {
+		age--
+	}
+	return max(age, 0)
+}
+
+// GetWorkSpeed returns the multiplier of the progress made on tasks due to the character's life stage
+func (character *Character) GetWorkSpeed() float32 {
+	switch character.LifeStage {
+	case Child:
+		return config.ChildWorkSpeed
+	case Elder:
+		return config.ElderWorkSpeed
+	}
+	return 1
+}
+
+// UpdateAging runs once a day: birthdays, life stages, old age, couples and births
+func (sim *Sim) UpdateAging() {
+	type couple struct{ a, b int16 }
+	var births []couple
+
+	for i := range sim.Characters {
+		character := &sim.Characters[i]
+		age := character.GetAge(sim.Calendar)
+
+		if sim.Calendar.Day == character.BirthDay && sim.Calendar.Year != character.BirthYear {
+			sim.AddThought(character, ThoughtBirthday)
+			sim.RecordEvent(EventBirthday, character.ID, fmt.Sprintf("%v turned %d", character.Name, age))
+		}
+
+		if stage := GetLifeStageForAge(age); stage != character.LifeStage {
+			character.LifeStage = stage
+			sim.RecordEvent(EventLifeStage, character.ID, fmt.Sprintf("%v is now an %v", character.Name, stage))
+			if stage == Adult && len(character.Ambitions) == 0 {
+				sim.GenerateAmbition(character)
+			}
+		}
+
+		if age >= config.OldAgeDeathAge {
+			chance := float32(age-config.OldAgeDeathAge+1) * config.OldAgeDeathChancePerYear
+			if sim.RNG.Chance(chance) {
+				// removed with the other dead characters at the end of the update
+				character.Health = 0
+				character.LastDamageCause = OldAge
+				continue
+			}
+		}
+
+		if character.PartnerID == -1 {
+			if partner := sim.FindPartner(character); partner != nil && sim.RNG.Chance(config.CoupleChance) {
+				character.PartnerID = partner.ID
+				partner.PartnerID = character.ID
+				sim.AddThought(character, ThoughtFoundPartner)
+				sim.AddThought(partner, ThoughtFoundPartner)
+				sim.RecordEvent(EventCouple, character.ID, fmt.Sprintf("%v and %v became a couple", character.Name, partner.Name))
+			}
+		} else if character.ID < character.PartnerID {
+			// each couple is only considered once
+			partner := sim.GetCharacterByID(character.PartnerID)
+			colonyFull := len(sim.Characters)+len(births) >= config.MaxColonySize
+			if partner != nil && !colonyFull && character.CanHaveChildren(sim.Calendar) && partner.CanHaveChildren(sim.Calendar) && sim.RNG.Chance(config.BirthChance) {
+				births = append(births, couple{character.ID, partner.ID})
+			}
+		}
+	}
+
+	// children are added after the loop as appending to Characters invalidates pointers
+	for _, parents := range births {
+		sim.MakeChild(parents.a, parents.b)
+	}
+}
+
+// FindPartner returns a single adult who could become the character's partner, or nil
+// they need to like each other enough
+func (sim *Sim) FindPartner(character *Character) *Character {
+	if character.LifeStage == Child {
+		return nil
+	}
+	age := character.GetAge(sim.Calendar)
+	for i := range sim.Characters {
+		other := &sim.Characters[i]
+		if other.ID == character.ID || other.PartnerID != -1 || other.LifeStage == Child || character.IsFamily(other) {
+			continue
+		}
+		if character.GetOpinion(other.ID) < config.CoupleOpinion || other.GetOpinion(character.ID) < config.CoupleOpinion {
+			continue
+		}
+		ageGap := age - other.GetAge(sim.Calendar)
+		if ageGap > config.MaxCoupleAgeGap || ageGap < -config.MaxCoupleAgeGap {
+			continue
+		}
+		return other
+	}
+	return nil
+}
+
+func (character *Character) CanHaveChildren(calendar Calendar) bool {
+	return character.LifeStage == Adult && character.GetAge(calendar) <= config.MaxParentAge && character.Health > 0
+}
+
+// IsFamily returns true for partners, parents, children and siblings
+func (character *Character) IsFamily(other *Character) bool {
+	if character.PartnerID == other.ID || other.PartnerID == character.ID {
+		return true
+	}
+	for _, parentID := range character.ParentIDs {
+		if parentID == other.ID {
+			return true
+		}
+		for _, otherParentID := range other.ParentIDs {
+			if parentID == otherParentID {
+				return true
+			}
+		}
+	}
+	for _, parentID := range other.ParentIDs {
+		if parentID == character.ID {
+			return true
+		}
+	}
+	return false
+}
+
+// GetCharacterName returns the name of a character, dead or alive
+func (sim *Sim) GetCharacterName(id int16) string {
+	if character := sim.GetCharacterByID(id); character != nil {
+		return character.Name
+	}
+	for _, deceased := range sim.Deceased {
+		if deceased.ID == id {
+			return deceased.Name + " (dead)"
+		}
+	}
+	return fmt.Sprintf("Unknown %d", id)
+}
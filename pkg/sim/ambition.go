@@ -0,0 +1,228 @@
+package sim
+
+import (
+	"fmt"
+	"gociv/pkg/config"
+	"gociv/pkg/data"
+	"strings"
+)
+
+// Ambitions are long-term personal goals, they spawn objectives until achieved, see UpdateAmbitions
+type AmbitionType int
+
+const (
+	AmbitionOwnHouse    AmbitionType = iota
+	AmbitionMasterSkill              // Variant is the SkillType
+	AmbitionFamily
+	AmbitionStockpile // Variant is the food variant
+)
+
+var AmbitionTypes = []AmbitionType{AmbitionOwnHouse, AmbitionMasterSkill, AmbitionFamily, AmbitionStockpile}
+
+func (at AmbitionType) String() string {
+	switch at {
+	case AmbitionOwnHouse:
+		return "Own house"
+	case AmbitionMasterSkill:
+		return "Master skill"
+	case AmbitionFamily:
+		return "Family"
+	case AmbitionStockpile:
+		return "Stockpile"
+	default:
+		return "Unknown"
+	}
+}
+
+// Objective spawned to train a skill, skills not in the map can't be an ambition yet
+var ambitionSkillObjectives = map[SkillType]ObjectiveType{
+	SkillFarming:      MakeFoodObjective,
+	SkillConstruction: WorkObjective,
+	SkillHauling:      WorkObjective,
+}
+
+// GenerateAmbition gives a random ambition to a character, e.g. when they become an adult
+func (sim *Sim) GenerateAmbition(character *Character) {
+	ambition := Ambition{
+		Type:        AmbitionTypes[sim.RNG.Intn(len(AmbitionTypes))],
+		StructureID: -1,
+	}
+	switch ambition.Type {
+	case AmbitionMasterSkill:
+		// master the skill they're best at
+		bestLevel := -1
+		for _, skillType := range Skills {
+			if _, ok := ambitionSkillObjectives[skillType]; ok && int(character.GetSkillLevel(skillType)) > bestLevel {
+				ambition.Variant = int16(skillType)
+				bestLevel = int(character.GetSkillLevel(skillType))
+			}
+		}
+		ambition.Target = min(max(config.MasterSkillLevel, bestLevel+1), config.MaxSkillLevel)
+	case AmbitionStockpile:
+		variants := GetFarmedFoodVariants()
+		if len(variants) == 0 {
+			ambition.Type = AmbitionOwnHouse
+			break
+		}
+		ambition.Variant = variants[sim.RNG.Intn(len(variants))]
+		ambition.Target = config.StockpileAmbitionCount
+	}
+	ambition.Description = ambition.Describe()
+	character.Ambitions = append(character.Ambitions, ambition)
+	fmt.Printf("%v wants to: %v\n", character.Name, ambition.Description)
+}
+
+// Describe returns the text displayed for an ambition, e.g. "Master farming"
+func (ambition *Ambition) Describe() string {
+	switch ambition.Type {
+	case AmbitionOwnHouse:
+		return "Build my own house"
+	case AmbitionMasterSkill:
+		return fmt.Sprintf("Master %v (level %d)", strings.ToLower(SkillType(ambition.Variant).String()), ambition.Target)
+	case AmbitionFamily:
+		return "Have a family"
+	case AmbitionStockpile:
+		name := "food"
+		if def, ok := data.GetItemDefinition(int(ItemTypeFood), ambition.Variant); ok {
+			name = def.Name
+		}
+		return fmt.Sprintf("Stockpile %d %v", ambition.Target, name)
+	}
+	return ambition.Type.String()
+}
+
+// GetFarmedFoodVariants returns the food variants which can be grown from seeds, fields produce the variant of their seeds
+func GetFarmedFoodVariants() []int16 {
+	var variants []int16
+	for _, plantVariants := range data.PlantDefinitionsMap {
+		for _, def := range plantVariants {
+			if def.Seeds.Count == 0 {
+				continue
+			}
+			if _, ok := data.GetItemDefinition(int(ItemTypeFood), def.Seeds.Variant); ok {
+				variants = append(variants, def.Seeds.Variant)
+			}
+		}
+	}
+	return variants
+}
+
+// UpdateAmbitions tracks the progress of the character's ambitions and spawns the objectives to get closer to them
+// achieving an ambition gives a lasting mood bonus
+func (sim *Sim) UpdateAmbitions(character *Character) {
+	for i := range character.Ambitions {
+		ambition := &character.Ambitions[i]
+		if ambition.Completed {
+			continue
+		}
+		ambition.Progress = sim.GetAmbitionProgress(character, ambition)
+		if ambition.Progress >= 1 {
+			ambition.Completed = true
+			sim.AddThought(character, ThoughtAchievedAmbition)
+			sim.RecordEvent(EventAmbition, character.ID, fmt.Sprintf("%v achieved an ambition: %v", character.Name, ambition.Description))
+			continue
+		}
+		if character.LifeStage != Child {
+			sim.PursueAmbition(character, ambition)
+		}
+	}
+}
+
+// GetAmbitionProgress returns how close the character is to achieving an ambition, from 0 to 1
+func (sim *Sim) GetAmbitionProgress(character *Character, ambition *Ambition) float32 {
+	switch ambition.Type {
+	case AmbitionOwnHouse:
+		// the bed built for the ambition becomes theirs once finished
+		if ambition.StructureID != -1 {
+			site := sim.GetStructurePtrByID(ambition.StructureID)
+			if site == nil || site.StructureType != Bed {
+				ambition.StructureID = -1
+			} else if site.BuildProgress < 100 {
+				return float32(site.BuildProgress) / 100 * 0.5
+			} else {
+				if site.Owner == -1 {
+					site.Owner = character.ID
+				}
+				ambition.StructureID = -1
+			}
+		}
+		// a house is an owned bed in a room
+		progress := float32(0)
+		for _, bed := range sim.StructureManager.GetStructuresByOwnerAndType(character.ID, Bed) {
+			if bed.BuildProgress < 100 {
+				continue
+			}
+			if sim.GetTileAt(bed.Position).ZoneType == ZoneTypeRoom {
+				return 1
+			}
+			progress = 0.5
+		}
+		return progress
+	case AmbitionMasterSkill:
+		if ambition.Target == 0 {
+			return 1
+		}
+		return min(float32(character.GetSkillLevel(SkillType(ambition.Variant)))/float32(ambition.Target), 1)
+	case AmbitionFamily:
+		if len(character.ChildrenIDs) > 0 {
+			return 1
+		}
+		if character.PartnerID != -1 {
+			return 0.5
+		}
+		return 0
+	case AmbitionStockpile:
+		if ambition.Target == 0 {
+			return 1
+		}
+		return min(float32(sim.CountStoredItems(ItemTypeFood, ambition.Variant))/float32(ambition.Target), 1)
+	}
+	return 0
+}
+
+// PursueAmbition adds the objective getting the character closer to an ambition, if it makes sense right now
+func (sim *Sim) PursueAmbition(character *Character, ambition *Ambition) {
+	objectiveType := NoObjective
+	switch ambition.Type {
+	case AmbitionOwnHouse:
+		if ambition.StructureID != -1 || len(sim.StructureManager.GetStructuresByOwnerAndType(character.ID, Bed)) > 0 {
+			return
+		}
+		// build a bed in a room, it's built like any construction site by whoever works on it
+		position := sim.ScanForTileMatching(character.TilePosition, config.UtilityScanDistance, func(tile *Tile) bool {
+			return tile.ZoneType == ZoneTypeRoom && tile.Structure == -1 && len(tile.Items) == 0
+		})
+		if position == nil {
+			return
+		}
+		ambition.StructureID = sim.PlaceConstructionSite(*position, Bed)
+		fmt.Printf("%v placed a bed to build at %v for their house\n", character.Name, *position)
+		return
+	case AmbitionMasterSkill:
+		objectiveType = ambitionSkillObjectives[SkillType(ambition.Variant)]
+	case AmbitionFamily:
+		// seek company before feeling lonely
+		if character.PartnerID == -1 && character.LifeStage == Adult && !character.IsNeedSatisfied(NeedSocial) {
+			objectiveType = SocializeObjective
+		}
+	case AmbitionStockpile:
+		objectiveType = MakeFoodObjective
+	}
+
+	switch objectiveType {
+	case NoObjective:
+		return
+	case MakeFoodObjective:
+		// it would be achieved right away
+		if sim.GetCropCount() >= config.PlantSeedsAtLeast {
+			return
+		}
+	case WorkObjective:
+		if !sim.HasWork(character) {
+			return
+		}
+	}
+	if !character.HasObjective(objectiveType) {
+		sim.AddObjective(character, objectiveType, 0)
+	}
+}
@@ -0,0 +1,31 @@
+package sim
+
+import "gociv/pkg/config"
+
+type Season int
+
+const (
+	Spring Season = iota
+	Summer
+	Autumn
+	Winter
+)
+
+func (s Season) String() string {
+	switch s {
+	case Spring:
+		return "Spring"
+	case Summer:
+		return "Summer"
+	case Autumn:
+		return "Autumn"
+	case Winter:
+		return "Winter"
+	default:
+		return "Unknown"
+	}
+}
+
+func (c Calendar) Season() Season {
+	return Season(int(c.Day) / config.DaysPerSeason % 4)
+}
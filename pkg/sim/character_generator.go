@@ -0,0 +1,105 @@
+package sim
+
+import (
+	"fmt"
+	"gociv/pkg/config"
+	"gociv/pkg/data"
+)
+
+// GenerateCharacter creates a random character using the sim RNG: name, age, traits, backstory and starting skills
+func (sim *Sim) GenerateCharacter(pos TilePosition) *Character {
+	character := sim.MakeCharacter(sim.GenerateName(), pos)
+	age := sim.RNG.IntRange(config.GeneratedMinAge, config.GeneratedMaxAge)
+	character.BirthDay = int8(sim.RNG.Intn(config.DaysPerYear))
+	character.BirthYear = sim.Calendar.Year - int16(age)
+	if character.BirthDay > sim.Calendar.Day {
+		character.BirthYear--
+	}
+	character.LifeStage = GetLifeStageForAge(age)
+
+	// traits, skipping the ones excluded by traits already picked
+	traitCount := sim.RNG.IntRange(1, config.MaxTraits)
+	for attempt := 0; attempt < 10 && len(character.Traits) < traitCount && len(data.TraitDefinitions) > 0; attempt++ {
+		traitType := TraitType(data.TraitDefinitions[sim.RNG.Intn(len(data.TraitDefinitions))].TraitType)
+		if character.CanHaveTrait(traitType) {
+			character.AddTrait(traitType)
+		}
+	}
+
+	// backstory
+	childhood := data.Backstories.Childhoods[sim.RNG.Intn(len(data.Backstories.Childhoods))]
+	adulthood := data.Backstories.Adulthoods[sim.RNG.Intn(len(data.Backstories.Adulthoods))]
+	character.Backstory = fmt.Sprintf("%s %s, %s.", character.Name, childhood.Description, adulthood.Description)
+
+	// starting skills: a bit of random talent plus what was learned in life
+	for _, skillType := range Skills {
+		level := sim.RNG.IntRange(0, config.MaxRandomStartingSkill)
+		level += int(childhood.Skills[int(skillType)]) + int(adulthood.Skills[int(skillType)])
+		for _, trait := range character.Traits {
+			if def, ok := data.GetTraitDefinition(int(trait)); ok {
+				level += int(def.StartingSkills[int(skillType)])
+			}
+		}
+		character.Skills[skillType] = Skill{Level: uint8(min(level, config.MaxSkillLevel))}
+	}
+
+	sim.GenerateAmbition(character)
+
+	fmt.Printf("Generated %v, %d years old, traits %v: %v\n", character.Name, age, character.Traits, character.Backstory)
+	return character
+}
+
+// MakeChild creates a newborn next to its first parent, it can inherit their traits
+func (sim *Sim) MakeChild(parentAID, parentBID int16) *Character {
+	parentA := sim.GetCharacterByID(parentAID)
+	parentB := sim.GetCharacterByID(parentBID)
+	if parentA == nil || parentB == nil {
+		return nil
+	}
+	// copy what's needed from the parents, the pointers are invalidated when the child is added
+	position := parentA.TilePosition
+	parentTraits := append(append([]TraitType{}, parentA.Traits...), parentB.Traits...)
+	parentNames := parentA.Name + " and " + parentB.Name
+
+	child := sim.MakeCharacter(sim.GenerateName(), position)
+	child.ParentIDs = []int16{parentAID, parentBID}
+	child.Backstory = fmt.Sprintf("%s was born in the colony to %s.", child.Name, parentNames)
+	for _, trait := range parentTraits {
+		if child.CanHaveTrait(trait) && sim.RNG.Chance(config.InheritTraitChance) {
+			child.AddTrait(trait)
+		}
+	}
+	childID := child.ID
+	sim.RecordEvent(EventBirth, childID, fmt.Sprintf("%v was born to %v", child.Name, parentNames))
+
+	for _, parentID := range child.ParentIDs {
+		parent := sim.GetCharacterByID(parentID)
+		parent.ChildrenIDs = append(parent.ChildrenIDs, childID)
+		parent.ChangeOpinion(childID, config.ParentChildOpinion)
+		child.ChangeOpinion(parentID, config.ParentChildOpinion)
+		sim.AddThought(parent, ThoughtHadChild)
+	}
+	return sim.GetCharacterByID(childID)
+}
+
+// GenerateName picks a random name, avoiding the names of living characters when possible
+func (sim *Sim) GenerateName() string {
+	names := data.Backstories.Names
+	var available []string
+	for _, name := range names {
+		used := false
+		for i := range sim.Characters {
+			if sim.Characters[i].Name == name {
+				used = true
+				break
+			}
+		}
+		if !used {
+			available = append(available, name)
+		}
+	}
+	if len(available) == 0 {
+		available = names
+	}
+	return available[sim.RNG.Intn(len(available))]
+}
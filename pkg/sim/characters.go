@@ -3,48 +3,24 @@ package sim
 import (
 	"fmt"
 	"gociv/pkg/config"
+	"slices"
 )
 
 const CHARACTER_SPEED = 100
 
 func (sim *Sim) InitCharacters() {
-	sim.MakeCharacter("Henry", TilePosition{
-		X: 25,
-		Y: 25,
-	})
-	// sim.MakeCharacter("Emma", TilePosition{
-	// 	X: 11,
-	// 	Y: 13,
-	// })
-	// sim.MakeCharacter("Lise", TilePosition{
-	// 	X: 11,
-	// 	Y: 14,
-	// })
-	// sim.MakeCharacter("Ousmane", TilePosition{
-	// 	X: 12,
-	// 	Y: 10,
-	// })
-	// sim.MakeCharacter("Molly", TilePosition{
-	// 	X: 12,
-	// 	Y: 12,
-	// })
-	// sim.MakeCharacter("Robert", TilePosition{
-	// 	X: 20,
-	// 	Y: 14,
-	// })
-	// sim.MakeCharacter("Didier", TilePosition{
-	// 	X: 20,
-	// 	Y: 10,
-	// })
-	// sim.MakeCharacter("Morgane", TilePosition{
-	// 	X: 20,
-	// 	Y: 12,
-	// })
+	for i := 0; i < config.StartingCharacters; i++ {
+		sim.GenerateCharacter(TilePosition{
+			X: 25 + int16(i),
+			Y: 25,
+		})
+	}
 }
 
-func (sim *Sim) MakeCharacter(name string, pos TilePosition) {
+// MakeCharacter adds a character to the sim and returns a pointer to it
+func (sim *Sim) MakeCharacter(name string, pos TilePosition) *Character {
 	character := Character{
-		ID:           int8(len(sim.Characters)),
+		ID:           sim.NextCharacterID,
 		Name:         name,
 		TilePosition: pos,
 		WorldPosition: WorldPosition{
@@ -52,39 +28,68 @@ func (sim *Sim) MakeCharacter(name string, pos TilePosition) {
 			Y: float32(pos.Y*config.TileSize + config.TileSize/2),
 		},
 		Needs: Needs{
-			Food:  100,
-			Water: 0,
-			Sleep: 0,
+			NeedFood: 100,
 		},
+		NeedModifiers: map[NeedType]float32{},
+		BirthDay:      sim.Calendar.Day,
+		BirthYear:     sim.Calendar.Year,
+		LifeStage:     Child,
+		PartnerID:     -1,
+		Opinions:      map[int16]float32{},
+		Schedule:      DefaultSchedule(),
+		Mood:          config.BaseMood,
+		Skills:        map[SkillType]Skill{},
+		Health:        100,
 	}
+	sim.NextCharacterID++
 	sim.Characters = append(sim.Characters, character)
+	return &sim.Characters[len(sim.Characters)-1]
 }
 
 func (sim *Sim) UpdateCharacters() {
 	if sim.Time%config.CharacterNeedsUpdateInterval == 0 {
 		for i := range sim.Characters {
 			sim.Characters[i].UpdateNeeds()
+			sim.UpdateWarmth(&sim.Characters[i])
+			sim.UpdateHealth(&sim.Characters[i])
+			sim.UpdateMood(&sim.Characters[i])
 		}
 	}
+	if sim.Calendar.Hour == 0 && sim.Calendar.Minute == 0 {
+		sim.UpdateAging()
+	}
 	if sim.Time%config.CharacterObjectiveUpdateInterval == 0 {
 		for i := range sim.Characters {
 			sim.UpdateObjectives(&sim.Characters[i])
 		}
 	}
+	if sim.Time%config.AmbitionUpdateInterval == 0 {
+		for i := range sim.Characters {
+			sim.UpdateAmbitions(&sim.Characters[i])
+		}
+	}
 	if sim.Time%config.CharacterTaskUpdateInterval == 0 {
 		for i := range sim.Characters {
 			if sim.Characters[i].CurrentTask == nil {
 				sim.SetCurrentTask(&sim.Characters[i])
+			} else if sim.Time%config.CharacterInterruptCheckInterval == 0 {
+				sim.CheckTaskInterruption(&sim.Characters[i])
 			}
 			sim.WorkOnCurrentTask(&sim.Characters[i])
 		}
 	}
+	sim.RemoveDeadCharacters()
 }
 
-func (character *Character) UpdateNeeds() {
-	character.Needs.Food++
-	character.Needs.Water++
-	character.Needs.Sleep++
+// GetCharacterByID returns a pointer to the character with the given ID, or nil if not found
+// characters can die so their ID is not their index in the Characters slice
+func (sim *Sim) GetCharacterByID(id int16) *Character {
+	for i := range sim.Characters {
+		if sim.Characters[i].ID == id {
+			return &sim.Characters[i]
+		}
+	}
+	return nil
 }
 
 // GetInventoryItems returns all items of a specific type and variant in the character's inventory
@@ -100,6 +105,67 @@ func (sim *Sim) GetInventoryItems(character *Character, itemType ItemType, varia
 	return items
 }
 
+// Drop puts the task's item down on the character's tile
+func (sim *Sim) Drop(character *Character) {
+	task := character.CurrentTask
+	if task.TargetItem == nil || !slices.Contains(character.Inventory, task.TargetItem.ID) {
+		fmt.Printf("WARNING: %v has no item to drop\n", character.Name)
+		sim.CancelTask(character)
+		return
+	}
+	sim.DropItem(character, task.TargetItem)
+	task.Progress = 100
+}
+
+// DropItem moves an item from the character's inventory to its tile
+func (sim *Sim) DropItem(character *Character, item *Item) {
+	if item == nil {
+		return
+	}
+	fmt.Printf("%v drops %v\n", character.Name, item)
+	character.Inventory = slices.DeleteFunc(character.Inventory, func(id int32) bool { return id == item.ID })
+	item.Location = ItemLocation{LocationType: LocTile, TilePosition: character.TilePosition}
+	item.OwnedBy = -1
+	tile := sim.GetTileAt(character.TilePosition)
+	// merge into the stacks already there
+	sim.stackItem(item, tile.Items)
+	if item.StackCount == 0 {
+		sim.ItemManager.removeItem(item.ID)
+		return
+	}
+	tile.AddItem(item.ID)
+}
+
+// AddItemToInventory creates an item directly in the character's inventory, e.g. a harvested crop
+// it's merged into the stacks already carried, it returns the ID of the last stack it went to
+// what the character can't carry is left on their tile
+func (sim *Sim) AddItemToInventory(character *Character, item Item) int32 {
+	item.OwnedBy = -1
+	item.StackCount = max(item.StackCount, 1)
+	if item.Durability == 0 {
+		item.Durability = 100
+	}
+	if carryable := sim.GetCarryableCount(character, &item); carryable < item.StackCount {
+		excess := item
+		excess.StackCount = item.StackCount - carryable
+		item.StackCount = carryable
+		id := sim.AddItem(excess, ItemLocation{LocationType: LocTile, TilePosition: character.TilePosition})
+		if item.StackCount == 0 {
+			return id
+		}
+	}
+	id := sim.stackItem(&item, character.Inventory)
+	stackSize := GetStackSize(item.Type, item.Variant)
+	for item.StackCount > 0 {
+		stack := item
+		stack.StackCount = min(item.StackCount, stackSize)
+		item.StackCount -= stack.StackCount
+		id = sim.AddItem(stack, ItemLocation{LocationType: LocCharacter, CharacterID: character.ID})
+		character.Inventory = append(character.Inventory, id)
+	}
+	return id
+}
+
 func (sim *Sim) PickUp(character *Character) {
 	task := character.CurrentTask
 	item := task.TargetItem
@@ -109,9 +175,37 @@ func (sim *Sim) PickUp(character *Character) {
 		sim.CancelTask(character)
 		return
 	}
+	// take what the character can carry
+	count := task.Count
+	if count == 0 || count > item.StackCount {
+		count = item.StackCount
+	}
+	count = min(count, sim.GetCarryableCount(character, item))
+	if count == 0 {
+		fmt.Printf("WARNING: %v can't carry more to pick up %v\n", character.Name, item)
+		sim.CancelTask(character)
+		return
+	}
+	// take part of the stack, the rest stays on the tile as a new stack so the task keeps its item
+	if count < item.StackCount {
+		id := item.ID
+		if _, err := sim.SplitItem(id, item.StackCount-count); err != nil {
+			fmt.Printf("WARNING: %v\n", err)
+		}
+		item = sim.GetItemPtr(id)
+		task.TargetItem = item
+	}
 	fmt.Printf("Picking up %v\n", item)
-	character.Inventory = append(character.Inventory, item.ID)
-	item.Location.CharacterID = character.ID
+	item.Location = ItemLocation{LocationType: LocCharacter, CharacterID: character.ID}
 	tile.RemoveItem(item.ID)
 	task.Progress = 100
+	// hauled items are kept apart to be dropped in storage
+	if task.Objective == nil || task.Objective.Type != WorkObjective {
+		sim.stackItem(item, character.Inventory)
+		if item.StackCount == 0 {
+			sim.ItemManager.removeItem(item.ID)
+			return
+		}
+	}
+	character.Inventory = append(character.Inventory, item.ID)
 }
@@ -0,0 +1,248 @@
+package sim
+
+import (
+	"fmt"
+	"gociv/pkg/data"
+	"slices"
+)
+
+// Bills are queued by the player on a workshop, they're done as craft jobs using data/recipes.json
+type BillMode uint8
+
+const (
+	BillCount     BillMode = iota // make Target times the recipe
+	BillKeepStock                 // make the recipe while there is less than Target of its first output lying in the colony
+)
+
+func (bm BillMode) String() string {
+	switch bm {
+	case BillCount:
+		return "Make"
+	case BillKeepStock:
+		return "Keep in stock"
+	default:
+		return "Unknown"
+	}
+}
+
+// AddBill queues a recipe on a structure, it returns the new bill's ID
+func (sim *Sim) AddBill(structure *Structure, recipeID int, mode BillMode, target int) (int32, error) {
+	recipe, ok := data.GetRecipeDefinition(recipeID)
+	if !ok {
+		return -1, fmt.Errorf("unknown recipe %d", recipeID)
+	}
+	if StructureType(recipe.Structure) != structure.StructureType {
+		return -1, fmt.Errorf("%v is made at a %v, not a %v", recipe.Name, StructureType(recipe.Structure), structure.StructureType)
+	}
+	sim.NextBillID++
+	structure.Bills = append(structure.Bills, Bill{ID: sim.NextBillID, RecipeID: recipeID, Mode: mode, Target: target})
+	return sim.NextBillID, nil
+}
+
+// RemoveBill removes a bill from the structure it's queued on
+func (sim *Sim) RemoveBill(billID int32) bool {
+	structure, _ := sim.GetBill(billID)
+	if structure == nil {
+		return false
+	}
+	structure.Bills = slices.DeleteFunc(structure.Bills, func(bill Bill) bool { return bill.ID == billID })
+	return true
+}
+
+// GetBill returns a bill and the structure it's queued on, nil if not found
+func (sim *Sim) GetBill(billID int32) (*Structure, *Bill) {
+	var structure *Structure
+	var bill *Bill
+	sim.StructureManager.ForEach(func(id int, s *Structure) {
+		for i := range s.Bills {
+			if s.Bills[i].ID == billID {
+				structure = s
+				bill = &s.Bills[i]
+			}
+		}
+	})
+	return structure, bill
+}
+
+// DescribeBill returns a line such as "Make Bread 3/10"
+func (sim *Sim) DescribeBill(bill *Bill) string {
+	name := fmt.Sprintf("Recipe %d", bill.RecipeID)
+	if recipe, ok := data.GetRecipeDefinition(bill.RecipeID); ok {
+		name = recipe.Name
+	}
+	if bill.Mode == BillKeepStock {
+		if recipe, ok := data.GetRecipeDefinition(bill.RecipeID); ok && len(recipe.Outputs) > 0 {
+			output := recipe.Outputs[0]
+			return fmt.Sprintf("%v %v %d/%d", bill.Mode, name, sim.CountStoredItems(ItemType(output.Type), output.Variant), bill.Target)
+		}
+	}
+	return fmt.Sprintf("%v %v %d/%d", bill.Mode, name, bill.Done, bill.Target)
+}
+
+// IsBillActive returns true while a bill has something to make
+func (sim *Sim) IsBillActive(bill *Bill) bool {
+	switch bill.Mode {
+	case BillCount:
+		return bill.Done < bill.Target
+	case BillKeepStock:
+		recipe, ok := data.GetRecipeDefinition(bill.RecipeID)
+		if !ok || len(recipe.Outputs) == 0 {
+			return false
+		}
+		output := recipe.Outputs[0]
+		return sim.CountStoredItems(ItemType(output.Type), output.Variant) < bill.Target
+	}
+	return false
+}
+
+// HasRecipeInputs returns true if all inputs of a recipe can be found, carried or lying around
+func (sim *Sim) HasRecipeInputs(recipe *data.RecipeDefinition) bool {
+	for _, input := range recipe.Inputs {
+		if sim.CountStoredItems(ItemType(input.Type), input.Variant) < int(input.Count) {
+			return false
+		}
+	}
+	return true
+}
+
+// CountStoredItems returns how many units of an item lie in the colony, carried items are not counted
+func (sim *Sim) CountStoredItems(itemType ItemType, variant int16) int {
+	count := 0
+	sim.ItemManager.ForEach(func(id int32, item *Item) {
+		if item.Type == itemType && item.Variant == variant && item.Location.LocationType == LocTile {
+			count += int(item.StackCount)
+		}
+	})
+	return count
+}
+
+// PostCraftJobs posts a craft job for the first bill of each workshop which can be done
+// a structure is used by one character at a time so it only has one craft job
+func (sim *Sim) PostCraftJobs() {
+	sim.StructureManager.ForEach(func(id int, s *Structure) {
+		if s.BuildProgress < 100 || len(s.Bills) == 0 {
+			return
+		}
+		for i := range sim.Jobs {
+			if sim.Jobs[i].Type == JobCraft && sim.Jobs[i].StructureID == s.ID {
+				return
+			}
+		}
+		for i := range s.Bills {
+			recipe, ok := data.GetRecipeDefinition(s.Bills[i].RecipeID)
+			if ok && sim.IsBillActive(&s.Bills[i]) && sim.HasRecipeInputs(recipe) {
+				sim.PostJob(Job{Type: JobCraft, Position: s.Position, StructureID: s.ID, BillID: s.Bills[i].ID})
+				return
+			}
+		}
+	})
+}
+
+// GetNextCraftingTask brings the inputs of the job's recipe to its workshop, then crafts
+func (sim *Sim) GetNextCraftingTask(character *Character, objective *Objective, job *Job) *Task {
+	structure, bill := sim.GetBill(job.BillID)
+	if bill == nil {
+		return nil
+	}
+	recipe, ok := data.GetRecipeDefinition(bill.RecipeID)
+	if !ok {
+		return nil
+	}
+	// fetch what's missing
+	for _, input := range recipe.Inputs {
+		carried := 0
+		for _, item := range sim.GetInventoryItems(character, ItemType(input.Type), input.Variant) {
+			carried += int(item.StackCount)
+		}
+		if carried >= int(input.Count) {
+			continue
+		}
+		item := sim.ScanForItem(character.ID, character.TilePosition, -1, ItemType(input.Type), input.Variant, true)
+		if item == nil {
+			ObjectiveFailed(character, objective)
+			return nil
+		}
+		if !item.Location.TilePosition.IsSameAs(character.TilePosition) {
+			return &Task{Objective: objective, Type: Move, TargetTile: &item.Location.TilePosition}
+		}
+		task := &Task{Objective: objective, Type: PickUp, TargetItem: item, Count: uint8(int(input.Count) - carried)}
+		sim.ReserveItem(character, task, item)
+		return task
+	}
+	// then work next to the workshop
+	if IsAdjacent(character.TilePosition.X, character.TilePosition.Y, structure.Position.X, structure.Position.Y) {
+		task := &Task{Objective: objective, Type: Craft, TargetTile: &structure.Position, ProductType: bill.RecipeID}
+		sim.ReserveStructure(character, task, structure)
+		return task
+	}
+	path := sim.FindPath(character.TilePosition, structure.Position, 1)
+	if len(path) == 0 {
+		ObjectiveFailed(character, objective)
+		return nil
+	}
+	return &Task{Objective: objective, Type: Move, TargetTile: &path[len(path)-1]}
+}
+
+// Craft works on the recipe of the character's bill, its inputs are used and its outputs put on the workshop's tile once done
+func (sim *Sim) Craft(character *Character) {
+	task := character.CurrentTask
+	var bill *Bill
+	if job := sim.GetClaimedJob(character.ID); job != nil {
+		_, bill = sim.GetBill(job.BillID)
+	}
+	if bill == nil {
+		task.Progress = 100
+		return
+	}
+	recipe, ok := data.GetRecipeDefinition(bill.RecipeID)
+	if !ok || recipe.Work <= 0 {
+		fmt.Printf("Unknown recipe %d for %v\n", bill.RecipeID, character.Name)
+		sim.CancelTask(character)
+		return
+	}
+	skillType := SkillType(recipe.Skill)
+	bill.Progress = min(bill.Progress+100/recipe.Work*character.GetSkillSpeed(skillType)*sim.GetEquippedToolSpeed(character, Craft), 100)
+	task.Progress = bill.Progress
+	character.PracticeSkill(skillType)
+	fmt.Println("Crafting", character.Name, recipe.Name, bill.Progress)
+	if bill.Progress < 100 {
+		return
+	}
+	if !sim.ConsumeRecipeInputs(character, recipe) {
+		fmt.Printf("WARNING: %v is missing the inputs of %v\n", character.Name, recipe.Name)
+		sim.CancelTask(character)
+		return
+	}
+	quality := character.GetSkillQuality(skillType)
+	for _, output := range recipe.Outputs {
+		item := Item{Type: ItemType(output.Type), Variant: output.Variant, StackCount: output.Count}
+		if def, ok := data.GetItemDefinition(output.Type, output.Variant); ok {
+			item.Efficiency = uint8(min(float32(def.Efficiency)*quality, 255))
+		}
+		sim.AddItem(item, ItemLocation{LocationType: LocTile, TilePosition: *task.TargetTile})
+	}
+	bill.Done++
+	bill.Progress = 0
+	fmt.Printf("%v crafted %v\n", character.Name, recipe.Name)
+}
+
+// ConsumeRecipeInputs removes the inputs of a recipe from the character's inventory, false if some are missing
+func (sim *Sim) ConsumeRecipeInputs(character *Character, recipe *data.RecipeDefinition) bool {
+	for _, input := range recipe.Inputs {
+		carried := 0
+		for _, item := range sim.GetInventoryItems(character, ItemType(input.Type), input.Variant) {
+			carried += int(item.StackCount)
+		}
+		if carried < int(input.Count) {
+			return false
+		}
+	}
+	for _, input := range recipe.Inputs {
+		for range input.Count {
+			if item := sim.FindInInventory(character, ItemType(input.Type), input.Variant); item != nil {
+				sim.DecreaseItemStackCount(item.ID)
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,147 @@
+package sim
+
+import (
+	"fmt"
+	"gociv/pkg/config"
+	"gociv/pkg/data"
+	"slices"
+)
+
+// Characters equip one item per slot, equipped items stay in their inventory
+type EquipmentSlot uint8
+
+const (
+	SlotTool EquipmentSlot = iota
+	SlotWeapon
+)
+
+// EquipmentSlots lists all slots in display order
+var EquipmentSlots = []EquipmentSlot{SlotTool, SlotWeapon}
+
+func (es EquipmentSlot) String() string {
+	switch es {
+	case SlotTool:
+		return "Tool"
+	case SlotWeapon:
+		return "Weapon"
+	default:
+		return "Unknown"
+	}
+}
+
+// GetEquipmentSlot returns the slot an item type is equipped in
+func GetEquipmentSlot(itemType ItemType) (EquipmentSlot, bool) {
+	switch itemType {
+	case ItemTypeTool:
+		return SlotTool, true
+	case ItemTypeWeapon:
+		return SlotWeapon, true
+	}
+	return 0, false
+}
+
+// GetEquippedItem returns the item equipped in a slot, nil if none
+// an item which left the inventory, e.g. dropped or broken, isn't equipped anymore
+func (sim *Sim) GetEquippedItem(character *Character, slot EquipmentSlot) *Item {
+	itemID, ok := character.Equipment[slot]
+	if !ok {
+		return nil
+	}
+	if !slices.Contains(character.Inventory, itemID) {
+		delete(character.Equipment, slot)
+		return nil
+	}
+	return sim.GetItemPtr(itemID)
+}
+
+// GetToolSpeed returns the multiplier a tool gives to a task type, 1 if the tool doesn't help with it
+func GetToolSpeed(item *Item, taskType TaskType) float32 {
+	if item == nil {
+		return 1
+	}
+	if def, ok := data.GetItemDefinition(int(item.Type), item.Variant); ok {
+		if speed, ok := def.ToolSpeeds[int(taskType)]; ok {
+			return speed
+		}
+	}
+	return 1
+}
+
+// GetEquippedToolSpeed returns the multiplier the character's equipped tool gives to a task type
+func (sim *Sim) GetEquippedToolSpeed(character *Character, taskType TaskType) float32 {
+	return GetToolSpeed(sim.GetEquippedItem(character, SlotTool), taskType)
+}
+
+// Equip puts the task's item from the inventory in its slot, what was there goes back to the tile
+func (sim *Sim) Equip(character *Character) {
+	task := character.CurrentTask
+	item := task.TargetItem
+	slot, ok := GetEquipmentSlot(item.Type)
+	if !ok || !slices.Contains(character.Inventory, item.ID) {
+		fmt.Printf("WARNING: %v can't equip %v\n", character.Name, item)
+		sim.CancelTask(character)
+		return
+	}
+	previous := sim.GetEquippedItem(character, slot)
+	if character.Equipment == nil {
+		character.Equipment = map[EquipmentSlot]int32{}
+	}
+	character.Equipment[slot] = item.ID
+	fmt.Printf("%v equips %v\n", character.Name, GetItemName(item))
+	if previous != nil && previous.ID != item.ID {
+		sim.DropItem(character, previous)
+	}
+	task.Progress = 100
+}
+
+// FindBetterTool returns a tool better than the equipped one for a task type, carried tools first
+// nil if the equipped tool is the best around
+func (sim *Sim) FindBetterTool(character *Character, taskType TaskType) *Item {
+	speed := sim.GetEquippedToolSpeed(character, taskType)
+	// a carried tool, e.g. just picked up
+	var best *Item
+	for _, item := range sim.GetInventoryItems(character, ItemTypeTool, -1) {
+		if s := GetToolSpeed(item, taskType); s > speed {
+			best, speed = item, s
+		}
+	}
+	if best != nil {
+		return best
+	}
+	return sim.ScanForItemMatching(character.ID, character.TilePosition, config.ToolScanDistance, true, func(item *Item) bool {
+		return item.Type == ItemTypeTool && GetToolSpeed(item, taskType) > speed && sim.GetCarryableCount(character, item) > 0
+	})
+}
+
+// GetNextToolTask fetches and equips the best tool for a task type when it's better than the equipped one
+// it returns nil when the character is ready to work
+func (sim *Sim) GetNextToolTask(character *Character, objective *Objective, taskType TaskType) *Task {
+	tool := sim.FindBetterTool(character, taskType)
+	if tool == nil {
+		return nil
+	}
+	if slices.Contains(character.Inventory, tool.ID) {
+		return &Task{Objective: objective, Type: Equip, TargetItem: tool}
+	}
+	if !tool.Location.TilePosition.IsSameAs(character.TilePosition) {
+		return &Task{Objective: objective, Type: Move, TargetTile: &tool.Location.TilePosition}
+	}
+	task := &Task{Objective: objective, Type: PickUp, TargetItem: tool, Count: 1}
+	sim.ReserveItem(character, task, tool)
+	return task
+}
+
+// WearTool lowers the durability of the tool used for a tick of work, it breaks once worn out
+func (sim *Sim) WearTool(character *Character, taskType TaskType) {
+	tool := sim.GetEquippedItem(character, SlotTool)
+	if tool == nil || GetToolSpeed(tool, taskType) <= 1 || !sim.RNG.Chance(config.ToolWearChance) {
+		return
+	}
+	if tool.Durability > 1 {
+		tool.Durability--
+		return
+	}
+	fmt.Printf("%v's %v broke\n", character.Name, GetItemName(tool))
+	delete(character.Equipment, SlotTool)
+	sim.RemoveItem(tool.ID)
+}
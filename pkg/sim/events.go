@@ -0,0 +1,58 @@
+package sim
+
+import (
+	"fmt"
+	"gociv/pkg/config"
+)
+
+type EventType int
+
+const (
+	NoEvent EventType = iota
+	EventDeath
+	EventColonyLost
+	EventBirth
+	EventBirthday
+	EventCouple
+	EventLifeStage
+	EventAmbition
+)
+
+func (et EventType) String() string {
+	switch et {
+	case NoEvent:
+		return "None"
+	case EventDeath:
+		return "Death"
+	case EventColonyLost:
+		return "Colony lost"
+	case EventBirth:
+		return "Birth"
+	case EventBirthday:
+		return "Birthday"
+	case EventCouple:
+		return "Couple"
+	case EventLifeStage:
+		return "Life stage"
+	case EventAmbition:
+		return "Ambition"
+	default:
+		return "Unknown"
+	}
+}
+
+// RecordEvent adds an event to the sim history, only the last config.MaxEvents are kept
+func (sim *Sim) RecordEvent(eventType EventType, characterID int16, description string) {
+	event := Event{
+		Time:        sim.Time,
+		Calendar:    sim.Calendar,
+		Type:        eventType,
+		CharacterID: characterID,
+		Description: description,
+	}
+	fmt.Printf("EVENT: %v\n", description)
+	sim.Events = append(sim.Events, event)
+	if len(sim.Events) > config.MaxEvents {
+		sim.Events = sim.Events[len(sim.Events)-config.MaxEvents:]
+	}
+}
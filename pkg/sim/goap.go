@@ -0,0 +1,188 @@
+package sim
+
+import (
+	"fmt"
+	"gociv/pkg/config"
+)
+
+// WorldState is a set of facts about a character and its surroundings used by the planner
+// each fact is a bit, e.g. StateHasFood|StateCropGrowing
+type WorldState uint16
+
+const (
+	StateHasFood WorldState = 1 << iota // food in the character's inventory
+	StateFoodAvailable
+	StateHasSeed
+	StateSeedAvailable
+	StateFieldAvailable // a free field tile, or dirt to create a field on
+	StateCropGrowing
+	StateCropMature
+	StatePlantRipe // a grown plant which gives seeds
+	StateFed
+	StateSeedPlanted // only set by planting, the goal of making food
+)
+
+type GoapActionType int
+
+const (
+	ActionEat GoapActionType = iota
+	ActionPickUpFood
+	ActionPickUpSeed
+	ActionGatherSeeds
+	ActionPlantSeed
+	ActionWaitForCrop
+	ActionHarvestCrop
+)
+
+func (at GoapActionType) String() string {
+	switch at {
+	case ActionEat:
+		return "Eat"
+	case ActionPickUpFood:
+		return "Pick up food"
+	case ActionPickUpSeed:
+		return "Pick up seed"
+	case ActionGatherSeeds:
+		return "Gather seeds"
+	case ActionPlantSeed:
+		return "Plant seed"
+	case ActionWaitForCrop:
+		return "Wait for crop"
+	case ActionHarvestCrop:
+		return "Harvest crop"
+	default:
+		return "Unknown"
+	}
+}
+
+// GoapAction declares what an action needs and what it changes, see realizeAction for the tasks doing it
+type GoapAction struct {
+	Type          GoapActionType
+	Preconditions WorldState
+	Effects       WorldState // facts made true
+	Removes       WorldState // facts made false
+	Cost          int
+}
+
+var goapActions = []GoapAction{
+	{Type: ActionEat, Preconditions: StateHasFood, Effects: StateFed, Removes: StateHasFood, Cost: 1},
+	{Type: ActionPickUpFood, Preconditions: StateFoodAvailable, Effects: StateHasFood, Cost: 2},
+	{Type: ActionPickUpSeed, Preconditions: StateSeedAvailable, Effects: StateHasSeed, Cost: 2},
+	{Type: ActionGatherSeeds, Preconditions: StatePlantRipe, Effects: StateHasSeed, Removes: StatePlantRipe, Cost: 3},
+	{Type: ActionPlantSeed, Preconditions: StateHasSeed | StateFieldAvailable, Effects: StateCropGrowing | StateSeedPlanted, Removes: StateHasSeed, Cost: 2},
+	{Type: ActionWaitForCrop, Preconditions: StateCropGrowing, Effects: StateCropMature, Cost: 4},
+	{Type: ActionHarvestCrop, Preconditions: StateCropMature, Effects: StateHasFood | StateHasSeed, Removes: StateCropMature, Cost: 2},
+}
+
+// GetGoalState returns the facts an objective wants to be true, false if the objective is not planned by goals
+func (ot ObjectiveType) GetGoalState() (WorldState, bool) {
+	switch ot {
+	case EatObjective:
+		return StateFed, true
+	case MakeFoodObjective:
+		return StateSeedPlanted, true
+	}
+	return 0, false
+}
+
+// GetWorldState evaluates the facts the planner starts from
+func (sim *Sim) GetWorldState(character *Character) WorldState {
+	var state WorldState
+	if sim.FindInInventory(character, ItemTypeFood, -1) != nil {
+		state |= StateHasFood
+	}
+	if sim.ScanForItem(character.ID, character.TilePosition, -1, ItemTypeFood, -1, true) != nil {
+		state |= StateFoodAvailable
+	}
+	if sim.FindInInventory(character, ItemTypeSeed, -1) != nil {
+		state |= StateHasSeed
+	}
+	if sim.ScanForItem(character.ID, character.TilePosition, -1, ItemTypeSeed, -1, true) != nil {
+		state |= StateSeedAvailable
+	}
+	if sim.HasFreeFieldTile() || len(sim.GetSuitableFieldTiles(character)) > 0 {
+		state |= StateFieldAvailable
+	}
+	if sim.GetGrowingTilesCount() > 0 {
+		state |= StateCropGrowing
+	}
+	if sim.GetMatureTilesCount() > 0 {
+		state |= StateCropMature
+	}
+	if sim.FindRipePlant(character.TilePosition) != nil {
+		state |= StatePlantRipe
+	}
+	return state
+}
+
+// PlanActions returns the cheapest list of actions going from start to a state with all the goal facts, nil if there is none
+func PlanActions(start WorldState, goal WorldState) []GoapActionType {
+	type node struct {
+		state   WorldState
+		cost    int
+		actions []GoapActionType
+	}
+	open := []node{{state: start}}
+	bestCost := map[WorldState]int{start: 0}
+	for len(open) > 0 {
+		// uniform cost search: expand the cheapest node first
+		cheapest := 0
+		for i := range open {
+			if open[i].cost < open[cheapest].cost {
+				cheapest = i
+			}
+		}
+		current := open[cheapest]
+		open = append(open[:cheapest], open[cheapest+1:]...)
+		if current.state&goal == goal {
+			return current.actions
+		}
+		if len(current.actions) >= config.MaxPlanSteps {
+			continue
+		}
+		for _, action := range goapActions {
+			if current.state&action.Preconditions != action.Preconditions {
+				continue
+			}
+			next := (current.state | action.Effects) &^ action.Removes
+			cost := current.cost + action.Cost
+			if best, ok := bestCost[next]; ok && best <= cost {
+				continue
+			}
+			bestCost[next] = cost
+			actions := append(append([]GoapActionType{}, current.actions...), action.Type)
+			open = append(open, node{state: next, cost: cost, actions: actions})
+		}
+	}
+	return nil
+}
+
+// buildGoalPlan plans the actions reaching the objective's goal and turns them into tasks
+// tasks are only planned up to the first action whose outcome is known once it's done, e.g. what a harvest gives
+func (sim *Sim) buildGoalPlan(character *Character, objective *Objective, goal WorldState) []Task {
+	actions := PlanActions(sim.GetWorldState(character), goal)
+	if len(actions) == 0 {
+		fmt.Printf("No plan found for %v's %v\n", character.Name, objective.Type)
+		ObjectiveFailed(character, objective)
+		return []Task{}
+	}
+	objective.Actions = actions
+	fmt.Printf("%v plans %v: %v\n", character.Name, objective.Type, actions)
+
+	planner := newPlanner(character)
+	plan := []Task{}
+	for _, actionType := range actions {
+		tasks, predictable := sim.realizeAction(&planner, actionType)
+		if tasks == nil {
+			fmt.Printf("%v can't %v\n", character.Name, actionType)
+			sim.releasePlanReservations(character, plan)
+			ObjectiveFailed(character, objective)
+			return []Task{}
+		}
+		plan = append(plan, tasks...)
+		if !predictable {
+			break
+		}
+	}
+	return plan
+}
@@ -0,0 +1,137 @@
+package sim
+
+import "slices"
+
+// realizeAction returns the tasks doing a planned action, moving the planner and giving it items as the tasks would
+// predictable is false when the next actions can't be turned into tasks before this one is done
+// tasks is nil if the action can't be done
+func (sim *Sim) realizeAction(planner *Character, actionType GoapActionType) (tasks []Task, predictable bool) {
+	switch actionType {
+	case ActionEat:
+		food := sim.FindFoodInInventory(planner)
+		if food == nil {
+			return nil, false
+		}
+		return []Task{{Type: Eat, TargetItem: food}}, false
+
+	case ActionPickUpFood:
+		// friends eat together: look for food next to a friend who is eating first
+		var food *Item
+		if friend := sim.FindEatingFriend(planner); friend != nil {
+			food = sim.ScanForFood(planner.ID, friend.TilePosition, 1)
+		}
+		if food == nil {
+			food = sim.ScanForFood(planner.ID, planner.TilePosition, -1)
+		}
+		return sim.realizePickUp(planner, food, 1), true
+
+	case ActionPickUpSeed:
+		seed := sim.ScanForItem(planner.ID, planner.TilePosition, -1, ItemTypeSeed, -1, true)
+		return sim.realizePickUp(planner, seed, 0), true
+
+	case ActionGatherSeeds:
+		plant := sim.FindRipePlant(planner.TilePosition)
+		if plant == nil {
+			return nil, false
+		}
+		tasks := sim.realizeTool(planner, GatherSeeds)
+		tasks = append(tasks, planMove(planner, plant.Position)...)
+		return append(tasks, Task{Type: GatherSeeds, TargetTile: &plant.Position}), false
+
+	case ActionPlantSeed:
+		seeds := sim.GetInventoryItems(planner, ItemTypeSeed, -1)
+		if len(seeds) == 0 {
+			return nil, false
+		}
+		// without a free field tile, the field is created when planting starts, see PlantSeed
+		var freeTiles []TilePosition
+		if field := sim.GetClosestField(planner.TilePosition); field != nil {
+			freeTiles = field.GetFreeTiles()
+		}
+		if len(freeTiles) == 0 {
+			freeTiles = sim.GetSuitableFieldTiles(planner)
+		}
+		// skip the tiles others are about to plant
+		var target *TilePosition
+		for _, position := range freeTiles {
+			if !sim.Reservations.IsTileReserved(position, planner.ID) {
+				target = &position
+				break
+			}
+		}
+		if target == nil {
+			return nil, false
+		}
+		plant := Task{Type: PlantSeed, TargetTile: target, MaterialSource: seeds[0]}
+		sim.ReserveTile(planner, &plant, *target)
+		tasks := sim.realizeTool(planner, PlantSeed)
+		tasks = append(tasks, planMove(planner, *target)...)
+		return append(tasks, plant), false
+
+	case ActionWaitForCrop:
+		target := sim.FindClosestFieldTile(planner.ID, planner.TilePosition, false)
+		if target == nil {
+			return nil, false
+		}
+		return append(planMove(planner, *target), Task{Type: WaitForCrop, TargetTile: target}), false
+
+	case ActionHarvestCrop:
+		target := sim.FindClosestFieldTile(planner.ID, planner.TilePosition, true)
+		if target == nil {
+			return nil, false
+		}
+		harvest := Task{Type: Harvest, TargetTile: target}
+		sim.ReserveTile(planner, &harvest, *target)
+		return append(planMove(planner, *target), harvest), false
+	}
+	return nil, false
+}
+
+// realizePickUp goes to an item and picks up count units of it, or the whole stack if count is 0
+// the item is reserved for the pick up task
+func (sim *Sim) realizePickUp(planner *Character, item *Item, count uint8) []Task {
+	if item == nil {
+		return nil
+	}
+	carryable := sim.GetCarryableCount(planner, item)
+	if carryable == 0 {
+		return nil
+	}
+	if (count == 0 && carryable < item.StackCount) || count > carryable {
+		count = carryable
+	}
+	tasks := planMove(planner, item.Location.TilePosition)
+	pickUp := Task{Type: PickUp, TargetItem: item, Count: count}
+	sim.ReserveItem(planner, &pickUp, item)
+	planner.Inventory = append(planner.Inventory, item.ID)
+	return append(tasks, pickUp)
+}
+
+// realizeTool fetches and equips a better tool for a task type first, like GetNextToolTask does for jobs
+// it returns no tasks when the planner's tool is already the best around
+func (sim *Sim) realizeTool(planner *Character, taskType TaskType) []Task {
+	tool := sim.FindBetterTool(planner, taskType)
+	if tool == nil {
+		return []Task{}
+	}
+	tasks := []Task{}
+	if !slices.Contains(planner.Inventory, tool.ID) {
+		if tasks = sim.realizePickUp(planner, tool, 1); tasks == nil {
+			return []Task{}
+		}
+	}
+	if planner.Equipment == nil {
+		planner.Equipment = map[EquipmentSlot]int32{}
+	}
+	planner.Equipment[SlotTool] = tool.ID
+	return append(tasks, Task{Type: Equip, TargetItem: tool})
+}
+
+// planMove returns a move task to the target, none if the planner is already there
+func planMove(planner *Character, target TilePosition) []Task {
+	if planner.TilePosition.IsSameAs(target) {
+		return []Task{}
+	}
+	planner.TilePosition = target
+	return []Task{{Type: Move, TargetTile: &target}}
+}
@@ -0,0 +1,204 @@
+package sim
+
+import (
+	"fmt"
+	"gociv/pkg/config"
+	"gociv/pkg/data"
+)
+
+type DamageCause int
+
+const (
+	NoDamageCause DamageCause = iota
+	Starvation
+	Dehydration
+	Exhaustion
+	Exposure
+	Drowning
+	Burn
+	Lightning
+	OldAge
+	FoodPoisoning
+)
+
+func (dc DamageCause) String() string {
+	switch dc {
+	case NoDamageCause:
+		return "None"
+	case Starvation:
+		return "Starvation"
+	case Dehydration:
+		return "Dehydration"
+	case Exhaustion:
+		return "Exhaustion"
+	case Exposure:
+		return "Exposure"
+	case Drowning:
+		return "Drowning"
+	case Burn:
+		return "Burn"
+	case Lightning:
+		return "Lightning"
+	case OldAge:
+		return "Old age"
+	case FoodPoisoning:
+		return "Food poisoning"
+	default:
+		return "Unknown"
+	}
+}
+
+// UpdateHealth applies damage from unmet needs and hazards, and heals characters resting in a bed
+func (sim *Sim) UpdateHealth(character *Character) {
+	if sim.Time%config.NeedDamageInterval == 0 {
+		for _, def := range data.NeedDefinitions {
+			if def.DamageThreshold > 0 && character.GetNeed(NeedType(def.NeedType)) >= def.DamageThreshold {
+				sim.Damage(character, DamageCause(def.DamageCause), config.NeedDamage)
+			}
+		}
+	}
+
+	sim.CheckHazards(character)
+
+	if sim.IsRestingInBed(character) {
+		sim.Heal(character, config.HealthRecoveryInBed)
+		for i := len(character.Injuries) - 1; i >= 0; i-- {
+			if character.Injuries[i].Severity <= config.InjuryHealingInBed {
+				fmt.Printf("%v recovered from %v\n", character.Name, character.Injuries[i].Cause)
+				character.Injuries = append(character.Injuries[:i], character.Injuries[i+1:]...)
+			} else {
+				character.Injuries[i].Severity -= config.InjuryHealingInBed
+			}
+		}
+	}
+}
+
+// CheckHazards rolls for injuries caused by the character's surroundings
+func (sim *Sim) CheckHazards(character *Character) {
+	tile := sim.GetTileAt(character.TilePosition)
+	if tile.Type == TileTypeWater && sim.RNG.Chance(config.DrowningChance) {
+		sim.Injure(character, Drowning, config.DrowningSeverity)
+	}
+	if tile.Structure != -1 && sim.GetStructureByID(tile.Structure).StructureType == Fireplace && sim.RNG.Chance(config.BurnChance) {
+		sim.Injure(character, Burn, config.BurnSeverity)
+	}
+	if sim.Weather.Type == Storm && !tile.IsSheltered() && sim.RNG.Chance(config.LightningChance) {
+		sim.Injure(character, Lightning, config.LightningSeverity)
+	}
+}
+
+// Injure adds an injury to the character and damages its health by the injury severity
+func (sim *Sim) Injure(character *Character, cause DamageCause, severity uint8) {
+	fmt.Printf("%v is injured: %v (%d)\n", character.Name, cause, severity)
+	character.Injuries = append(character.Injuries, Injury{Cause: cause, Severity: severity})
+	sim.AddThought(character, ThoughtInjured)
+	sim.Damage(character, cause, int(severity))
+}
+
+func (sim *Sim) Damage(character *Character, cause DamageCause, amount int) {
+	if amount <= 0 || character.Health <= 0 {
+		return
+	}
+	character.Health = int8(max(0, int(character.Health)-amount))
+	character.LastDamageCause = cause
+}
+
+func (sim *Sim) Heal(character *Character, amount int) {
+	character.Health = int8(min(config.MaxHealth, int(character.Health)+amount))
+}
+
+func (sim *Sim) IsRestingInBed(character *Character) bool {
+	return character.CurrentTask != nil && character.CurrentTask.Type == Sleep &&
+		sim.FindStructureInTile(character.ID, character.TilePosition, Bed, -1, false) != nil
+}
+
+// RemoveDeadCharacters kills all characters whose health reached 0
+// It's done after all characters are updated as removing them invalidates pointers to the Characters slice
+func (sim *Sim) RemoveDeadCharacters() {
+	for i := len(sim.Characters) - 1; i >= 0; i-- {
+		if sim.Characters[i].Health <= 0 {
+			sim.KillCharacter(sim.Characters[i].ID, sim.Characters[i].LastDamageCause)
+		}
+	}
+}
+
+// KillCharacter removes a character from the sim: its inventory is dropped on its tile,
+// the structures and items it owned are released and the death is recorded
+func (sim *Sim) KillCharacter(characterID int16, cause DamageCause) {
+	character := sim.GetCharacterByID(characterID)
+	if character == nil {
+		return
+	}
+
+	// drop inventory
+	tile := sim.GetTileAt(character.TilePosition)
+	for _, itemID := range character.Inventory {
+		item := sim.GetItemPtr(itemID)
+		if item == nil {
+			continue
+		}
+		item.Location = ItemLocation{LocationType: LocTile, TilePosition: character.TilePosition}
+		tile.AddItem(itemID)
+	}
+	character.Inventory = nil
+
+	// release ownerships, reservations and jobs
+	sim.ReleaseJobs(characterID)
+	sim.ReleaseCharacterReservations(characterID)
+	sim.StructureManager.ForEach(func(id int, s *Structure) {
+		if s.Owner == characterID {
+			s.Owner = -1
+		}
+	})
+	sim.ItemManager.ForEach(func(id int32, item *Item) {
+		if item.OwnedBy == characterID {
+			item.OwnedBy = -1
+		}
+	})
+
+	sim.RecordEvent(EventDeath, characterID, fmt.Sprintf("%v died (%v)", character.Name, cause))
+	for i := range sim.Characters {
+		other := &sim.Characters[i]
+		if other.ID == characterID {
+			continue
+		}
+		if character.IsFamily(other) {
+			sim.AddThought(other, ThoughtFamilyDied)
+		} else {
+			sim.AddThought(other, ThoughtColonistDied)
+		}
+		if other.PartnerID == characterID {
+			other.PartnerID = -1
+		}
+	}
+
+	// keep the family graph
+	sim.Deceased = append(sim.Deceased, DeceasedCharacter{
+		ID:        character.ID,
+		Name:      character.Name,
+		BirthYear: character.BirthYear,
+		Death:     sim.Calendar,
+		Cause:     cause,
+		PartnerID: character.PartnerID,
+		ParentIDs: character.ParentIDs,
+	})
+
+	for i := range sim.Characters {
+		if sim.Characters[i].ID == characterID {
+			sim.Characters = append(sim.Characters[:i], sim.Characters[i+1:]...)
+			break
+		}
+	}
+	if sim.UI.SelectedCharacterIndex == characterID {
+		sim.UI.SelectedCharacterIndex = -1
+	}
+
+	if sim.IsColonyLost() {
+		sim.RecordEvent(EventColonyLost, -1, "The colony has perished")
+	}
+}
+
+// IsColonyLost is the failure state of the simulation: no character is left alive
+func (sim *Sim) IsColonyLost() bool {
+	return len(sim.Characters) == 0
+}
@@ -1,5 +1,7 @@
 package sim
 
+import "time"
+
 func InitSim() *Sim {
 	// region includes plants and structures
 	regionData := InitRegion()
@@ -11,8 +13,10 @@ func InitSim() *Sim {
 		ItemManager:      NewItemManager(),
 		PlantManager:     regionData.PlantManager,
 		StructureManager: regionData.StructureManager,
+		RNG:              NewRNG(uint64(time.Now().UnixNano())),
 	}
 
+	sim.UpdateRooms()
 	sim.InitItems()
 	sim.InitCharacters()
 	return &sim
@@ -0,0 +1,73 @@
+package sim
+
+import (
+	"gociv/pkg/config"
+	"gociv/pkg/data"
+)
+
+// Characters carry a limited weight and volume, see GetCarryCapacity
+// unit weights and volumes are defined in data/items.json
+
+// GetItemWeight returns the weight of a whole stack
+func GetItemWeight(item *Item) float32 {
+	if def, ok := data.GetItemDefinition(int(item.Type), item.Variant); ok {
+		return def.Weight * float32(item.StackCount)
+	}
+	return 0
+}
+
+// GetItemVolume returns the volume of a whole stack
+func GetItemVolume(item *Item) float32 {
+	if def, ok := data.GetItemDefinition(int(item.Type), item.Variant); ok {
+		return def.Volume * float32(item.StackCount)
+	}
+	return 0
+}
+
+// GetCarryCapacity returns the weight and volume a character can carry
+// hauling skill makes them stronger, injuries and age weaker, and containers like baskets add room
+func (sim *Sim) GetCarryCapacity(character *Character) (weight, volume float32) {
+	weight = config.BaseCarryWeight + float32(character.GetSkillLevel(SkillHauling))*config.CarryWeightPerSkillLevel
+	weight *= max(float32(character.Health)/config.MaxHealth, config.MinCarryHealthFactor) * character.GetWorkSpeed()
+	volume = config.BaseCarryVolume
+	for _, itemID := range character.Inventory {
+		item := sim.GetItemPtr(itemID)
+		if item == nil {
+			continue
+		}
+		if def, ok := data.GetItemDefinition(int(item.Type), item.Variant); ok {
+			weight += def.CarryWeight * float32(item.StackCount)
+			volume += def.CarryVolume * float32(item.StackCount)
+		}
+	}
+	return weight, volume
+}
+
+// GetCarriedLoad returns the weight and volume of the character's inventory
+func (sim *Sim) GetCarriedLoad(character *Character) (weight, volume float32) {
+	for _, itemID := range character.Inventory {
+		if item := sim.GetItemPtr(itemID); item != nil {
+			weight += GetItemWeight(item)
+			volume += GetItemVolume(item)
+		}
+	}
+	return weight, volume
+}
+
+// GetCarryableCount returns how many units of an item the character can add to their inventory, up to its stack count
+func (sim *Sim) GetCarryableCount(character *Character, item *Item) uint8 {
+	def, ok := data.GetItemDefinition(int(item.Type), item.Variant)
+	if !ok || (def.Weight <= 0 && def.Volume <= 0) {
+		return item.StackCount
+	}
+	maxWeight, maxVolume := sim.GetCarryCapacity(character)
+	weight, volume := sim.GetCarriedLoad(character)
+	count := float32(item.StackCount)
+	if def.Weight > 0 {
+		count = min(count, (maxWeight-weight)/def.Weight)
+	}
+	if def.Volume > 0 {
+		count = min(count, (maxVolume-volume)/def.Volume)
+	}
+	return uint8(max(count, 0))
+}
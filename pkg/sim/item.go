@@ -10,6 +10,13 @@ const (
 	ItemTypeTool
 	ItemTypeWeapon
 	ItemTypeSeed
+	ItemTypeMaterial
+)
+
+// Variants of ItemTypeMaterial, used to build structures
+const (
+	MaterialWood int16 = iota
+	MaterialStone
 )
 
 type ItemLocationType uint8
@@ -24,4 +31,6 @@ func (sim *Sim) InitItems() {
 	fmt.Printf("Initializing items\n")
 	location := ItemLocation{LocationType: LocTile, TilePosition: TilePosition{X: 16, Y: 16}}
 	sim.AddItem(Item{Type: ItemTypeSeed, Variant: 2, StackCount: 8}, location)
+	sim.AddItem(Item{Type: ItemTypeTool, Variant: 1}, location) // hoe
+	sim.AddItem(Item{Type: ItemTypeTool, Variant: 2}, location) // hammer
 }
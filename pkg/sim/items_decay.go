@@ -0,0 +1,112 @@
+package sim
+
+import (
+	"fmt"
+	"gociv/pkg/config"
+	"gociv/pkg/data"
+)
+
+// Items lose durability over time, see data/items.json decayDays
+// decayed food becomes rotten, other items are gone
+
+// UpdateItemDecay runs every config.ItemDecayInterval ticks
+func (sim *Sim) UpdateItemDecay() {
+	hoursPerUpdate := float32(config.ItemDecayInterval) / 60
+	temperatures := map[TilePosition]float32{}
+	fireplaces := sim.GetFireplaces()
+	var decayed []int32
+	sim.ItemManager.ForEach(func(id int32, item *Item) {
+		def, ok := data.GetItemDefinition(int(item.Type), item.Variant)
+		if !ok || def.DecayDays <= 0 {
+			return
+		}
+		loss := 100 / (def.DecayDays * 24) * hoursPerUpdate * sim.GetDecayFactor(item, temperatures, fireplaces)
+		// durability is a whole number, the fraction is lost by chance
+		whole := uint8(loss)
+		if sim.RNG.Chance(loss - float32(whole)) {
+			whole++
+		}
+		item.Durability -= min(item.Durability, whole)
+		if item.Durability == 0 {
+			decayed = append(decayed, id)
+		}
+	})
+	// rotting changes the items so it's done after going through them
+	for _, id := range decayed {
+		sim.RotItem(id)
+	}
+}
+
+// GetDecayFactor returns how fast an item decays where it is: slower in storage and in the cold
+// temperatures are cached by tile for the update, fireplaces are collected once by the caller
+func (sim *Sim) GetDecayFactor(item *Item, temperatures map[TilePosition]float32, fireplaces []TilePosition) float32 {
+	position := item.Location.TilePosition
+	if item.Location.LocationType == LocCharacter {
+		character := sim.GetCharacterByID(item.Location.CharacterID)
+		if character == nil {
+			return 1
+		}
+		position = character.TilePosition
+	}
+	factor := float32(1)
+	if item.Location.LocationType == LocTile && sim.IsInStorage(position) {
+		factor *= config.StorageDecayFactor
+	}
+	temperature, ok := temperatures[position]
+	if !ok {
+		temperature = sim.getTileTemperature(position, fireplaces)
+		temperatures[position] = temperature
+	}
+	if temperature <= config.ColdTemperature {
+		factor *= config.ColdDecayFactor
+	}
+	return factor
+}
+
+// RotItem turns a decayed item into its rotten variant, or removes it if it has none
+func (sim *Sim) RotItem(id int32) {
+	item := sim.GetItemPtr(id)
+	if item == nil {
+		return
+	}
+	def, ok := data.GetItemDefinition(int(item.Type), item.Variant)
+	if !ok || def.RottenVariant == -1 {
+		fmt.Printf("Item %d decayed\n", id)
+		sim.RemoveItem(id)
+		return
+	}
+	fmt.Printf("Item %d rotted\n", id)
+	item.Variant = def.RottenVariant
+	item.Durability = 100
+	if rotten, ok := data.GetItemDefinition(int(item.Type), item.Variant); ok {
+		item.Efficiency = rotten.Efficiency
+	}
+}
+
+// IsRotten returns true for spoiled food
+func IsRotten(item *Item) bool {
+	def, ok := data.GetItemDefinition(int(item.Type), item.Variant)
+	return ok && def.Rotten
+}
+
+// ScanForFood returns the closest food, rotten food is only eaten when there is nothing else
+func (sim *Sim) ScanForFood(characterID int16, position TilePosition, maxDistance int) *Item {
+	if food := sim.ScanForItemMatching(characterID, position, maxDistance, true, func(item *Item) bool {
+		return item.Type == ItemTypeFood && !IsRotten(item)
+	}); food != nil {
+		return food
+	}
+	return sim.ScanForItem(characterID, position, maxDistance, ItemTypeFood, -1, true)
+}
+
+// FindFoodInInventory returns the carried food to eat, fresh first
+func (sim *Sim) FindFoodInInventory(character *Character) *Item {
+	var rotten *Item
+	for _, item := range sim.GetInventoryItems(character, ItemTypeFood, -1) {
+		if !IsRotten(item) {
+			return item
+		}
+		rotten = item
+	}
+	return rotten
+}
@@ -128,6 +128,15 @@ func (im *ItemManager) getItems(itemType ItemType) []Item {
 	return result
 }
 
+// ForEach calls fn for each existing item.
+func (im *ItemManager) ForEach(fn func(id int32, item *Item)) {
+	for id := range im.items {
+		if im.usedSlots[id] {
+			fn(int32(id), &im.items[id])
+		}
+	}
+}
+
 // getFreeSlotCount returns the number of free slots available.
 func (im *ItemManager) getFreeSlotCount() int {
 	return len(im.freeSlots)
@@ -178,20 +187,49 @@ func (im *ItemManager) GobDecode(data []byte) error {
 		return err
 	}
 
+	// saves from before durability have it unset, those items are fresh
+	im.ForEach(func(id int32, item *Item) {
+		if item.Durability == 0 {
+			item.Durability = 100
+		}
+	})
+
 	return nil
 }
 
 // Item management convenience methods for Sim
-func (s *Sim) AddItemToOwner(item Item, location ItemLocation, owner int8) int32 {
+func (s *Sim) AddItemToOwner(item Item, location ItemLocation, owner int16) int32 {
 	item.OwnedBy = owner
-	return s.AddItem(item, location)
+	return s.addItem(item, location)
 }
 func (s *Sim) AddItem(item Item, location ItemLocation) int32 {
 	item.OwnedBy = -1
-	index, _ := s.ItemManager.addItem(item, location)
+	return s.addItem(item, location)
+}
+
+// addItem merges the item into the stacks of its tile, what doesn't fit makes new stacks
+// it returns the ID of the last stack the item went to
+func (s *Sim) addItem(item Item, location ItemLocation) int32 {
+	item.StackCount = max(item.StackCount, 1)
+	// new items are fresh
+	if item.Durability == 0 {
+		item.Durability = 100
+	}
+	index := int32(-1)
+	var tile *Tile
 	if location.LocationType == LocTile {
-		tile := s.GetTileAt(location.TilePosition)
-		tile.AddItem(index)
+		tile = s.GetTileAt(location.TilePosition)
+		index = s.stackItem(&item, tile.Items)
+	}
+	stackSize := GetStackSize(item.Type, item.Variant)
+	for item.StackCount > 0 {
+		stack := item
+		stack.StackCount = min(item.StackCount, stackSize)
+		item.StackCount -= stack.StackCount
+		index, _ = s.ItemManager.addItem(stack, location)
+		if tile != nil {
+			tile.AddItem(index)
+		}
 	}
 	return index
 }
@@ -205,8 +243,7 @@ func (s *Sim) RemoveItem(id int32) error {
 	} else if item.Location.LocationType == LocCharacter {
 		// Remove from character inventory
 		characterID := item.Location.CharacterID
-		if characterID >= 0 && characterID < int8(len(s.Characters)) {
-			character := &s.Characters[characterID]
+		if character := s.GetCharacterByID(characterID); character != nil {
 			for i, invItemID := range character.Inventory {
 				if invItemID == id {
 					character.Inventory = append(character.Inventory[:i], character.Inventory[i+1:]...)
@@ -218,12 +255,17 @@ func (s *Sim) RemoveItem(id int32) error {
 	}
 	return s.ItemManager.removeItem(id)
 }
+
+// DecreaseItemStackCount uses one unit of a stack, e.g. a seed, the item is removed with its last unit
 func (s *Sim) DecreaseItemStackCount(id int32) error {
-	item := s.ItemManager.getItem(id)
-	item.StackCount--
-	if item.StackCount <= 0 {
+	item := s.GetItemPtr(id)
+	if item == nil {
+		return fmt.Errorf("item id %d is not in use", id)
+	}
+	if item.StackCount <= 1 {
 		return s.RemoveItem(id)
 	}
+	item.StackCount--
 	return nil
 }
 func (s *Sim) GetItem(id int32) Item {
@@ -8,13 +8,20 @@ import (
 // ScanForItem searches the closest reachable item of a given type using BFS
 // Only explores passable tiles, so it respects walls and obstacles
 // if variant is irrelevant pass -1
-func (sim *Sim) ScanForItem(characterID int8, position TilePosition, maxDistance int, itemType ItemType, variant int16, unclaimedOnly bool) *Item {
+func (sim *Sim) ScanForItem(characterID int16, position TilePosition, maxDistance int, itemType ItemType, variant int16, unclaimedOnly bool) *Item {
+	return sim.ScanForItemMatching(characterID, position, maxDistance, unclaimedOnly, func(item *Item) bool {
+		return item.Type == itemType && (item.Variant == variant || variant == -1)
+	})
+}
+
+// ScanForItemMatching searches the closest reachable item for which match returns true using BFS
+func (sim *Sim) ScanForItemMatching(characterID int16, position TilePosition, maxDistance int, unclaimedOnly bool, match func(item *Item) bool) *Item {
 	// Check current tile first
 	if position.X >= 0 && position.X < config.RegionSize && position.Y >= 0 && position.Y < config.RegionSize {
 		tile := sim.GetTileAt(position)
 		for _, itemID := range tile.Items {
 			item := sim.GetItemPtr(itemID)
-			if item != nil && item.Type == itemType && (item.Variant == variant || variant == -1) && (!unclaimedOnly || item.OwnedBy == -1 || item.OwnedBy == characterID) {
+			if item != nil && match(item) && (!unclaimedOnly || sim.IsItemAvailable(characterID, item)) {
 				return item
 			}
 		}
@@ -64,7 +71,7 @@ func (sim *Sim) ScanForItem(characterID int8, position TilePosition, maxDistance
 				queue = append(queue, neighborPos)
 
 				// Check for items in this tile
-				item := sim.FindItemInTile(characterID, neighborPos, itemType, variant, unclaimedOnly)
+				item := sim.FindItemInTileMatching(characterID, neighborPos, unclaimedOnly, match)
 				if item != nil {
 					return item
 				}
@@ -80,14 +87,20 @@ func (sim *Sim) ScanForItem(characterID int8, position TilePosition, maxDistance
 	return nil
 }
 
-func (sim *Sim) FindItemInTile(characterID int8, position TilePosition, itemType ItemType, variant int16, unclaimedOnly bool) *Item {
+func (sim *Sim) FindItemInTile(characterID int16, position TilePosition, itemType ItemType, variant int16, unclaimedOnly bool) *Item {
+	return sim.FindItemInTileMatching(characterID, position, unclaimedOnly, func(item *Item) bool {
+		return item.Type == itemType && (item.Variant == variant || variant == -1)
+	})
+}
+
+func (sim *Sim) FindItemInTileMatching(characterID int16, position TilePosition, unclaimedOnly bool, match func(item *Item) bool) *Item {
 	if position.X == 1 && position.Y == 1 {
 		fmt.Printf("Finding item in tile %d, %d\n", position.X, position.Y)
 	}
 	tile := sim.GetTileAt(position)
 	for _, itemID := range tile.Items {
 		item := sim.GetItemPtr(itemID)
-		if item != nil && item.Type == itemType && (item.Variant == variant || variant == -1) && (!unclaimedOnly || item.OwnedBy == -1 || item.OwnedBy == characterID) {
+		if item != nil && match(item) && (!unclaimedOnly || sim.IsItemAvailable(characterID, item)) {
 			fmt.Printf("Found item %v ID %d %d for character %d\n", item, item.ID, itemID, characterID)
 			return item
 		}
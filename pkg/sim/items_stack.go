@@ -0,0 +1,104 @@
+package sim
+
+import (
+	"fmt"
+	"gociv/pkg/data"
+)
+
+// Items of the same type, variant and owner stack up to the StackSize of their definition
+// a stack is one item whose StackCount is the number of units, e.g. 8 seeds
+
+// GetStackSize returns how many units fit in one stack, 1 for items which don't stack
+func GetStackSize(itemType ItemType, variant int16) uint8 {
+	if def, ok := data.GetItemDefinition(int(itemType), variant); ok && def.StackSize > 1 {
+		return def.StackSize
+	}
+	return 1
+}
+
+// GetItemName returns the name of an item with its count and durability, e.g. "Potato x5 (80%)"
+func GetItemName(item *Item) string {
+	name := fmt.Sprintf("Item %d/%d", item.Type, item.Variant)
+	if def, ok := data.GetItemDefinition(int(item.Type), item.Variant); ok {
+		name = def.Name
+	}
+	if item.StackCount > 1 {
+		name = fmt.Sprintf("%s x%d", name, item.StackCount)
+	}
+	if item.Durability < 100 {
+		name = fmt.Sprintf("%s (%d%%)", name, item.Durability)
+	}
+	return name
+}
+
+// CanStack returns true if two items can be in the same stack
+func CanStack(a, b *Item) bool {
+	return a.Type == b.Type && a.Variant == b.Variant && a.OwnedBy == b.OwnedBy
+}
+
+// mergeInto moves as many units as fit from source into target and returns how many were moved
+// the efficiency and durability of the stack become the average of its units
+func mergeInto(target, source *Item) uint8 {
+	stackSize := GetStackSize(target.Type, target.Variant)
+	if target.StackCount >= stackSize {
+		return 0
+	}
+	moved := min(stackSize-target.StackCount, source.StackCount)
+	if moved == 0 {
+		return 0
+	}
+	total := float32(target.StackCount) + float32(moved)
+	target.Efficiency = uint8((float32(target.Efficiency)*float32(target.StackCount) + float32(source.Efficiency)*float32(moved)) / total)
+	target.Durability = uint8((float32(target.Durability)*float32(target.StackCount) + float32(source.Durability)*float32(moved)) / total)
+	target.StackCount += moved
+	source.StackCount -= moved
+	return moved
+}
+
+// stackItem merges an item into the stacks with the given IDs, e.g. the items of a tile or an inventory
+// it returns the ID of the last stack units were moved to, -1 if none, the item keeps what didn't fit
+func (sim *Sim) stackItem(item *Item, ids []int32) int32 {
+	lastID := int32(-1)
+	for _, id := range ids {
+		if item.StackCount == 0 {
+			break
+		}
+		stack := sim.GetItemPtr(id)
+		if stack == nil || stack.ID == item.ID || !CanStack(stack, item) {
+			continue
+		}
+		if mergeInto(stack, item) > 0 {
+			lastID = id
+		}
+	}
+	return lastID
+}
+
+// SplitItem takes count units out of a stack into a new item at the same place and returns the new item's ID
+// pointers to items are invalidated as the item manager may grow
+func (sim *Sim) SplitItem(id int32, count uint8) (int32, error) {
+	item := sim.GetItemPtr(id)
+	if item == nil {
+		return -1, fmt.Errorf("item id %d is not in use", id)
+	}
+	if count == 0 || count >= item.StackCount {
+		return -1, fmt.Errorf("can't split %d units out of item %d with %d units", count, id, item.StackCount)
+	}
+	item.StackCount -= count
+	split := *item
+	split.StackCount = count
+	newID, err := sim.ItemManager.addItem(split, split.Location)
+	if err != nil {
+		return -1, err
+	}
+	switch split.Location.LocationType {
+	case LocTile:
+		sim.GetTileAt(split.Location.TilePosition).AddItem(newID)
+	case LocCharacter:
+		if character := sim.GetCharacterByID(split.Location.CharacterID); character != nil {
+			character.Inventory = append(character.Inventory, newID)
+		}
+	}
+	fmt.Printf("Split %d units of item %d into item %d\n", count, id, newID)
+	return newID, nil
+}
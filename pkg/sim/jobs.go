@@ -0,0 +1,378 @@
+package sim
+
+import (
+	"fmt"
+	"gociv/pkg/config"
+	"slices"
+)
+
+// Jobs are posted by the sim on a central board and claimed by characters, see UpdateJobs
+type JobType int
+
+const (
+	JobHaul JobType = iota
+	JobHarvest
+	JobBuild
+	JobRepair
+	JobCraft
+	JobChop
+	JobMine
+	JobTerrain
+)
+
+// JobTypes lists all job types in the order of the work priorities panel
+var JobTypes = []JobType{JobHaul, JobHarvest, JobBuild, JobRepair, JobCraft, JobChop, JobMine, JobTerrain}
+
+// Task type doing each job, the tool speeding it up is fetched before starting, see GetNextToolTask
+var jobTaskTypes = map[JobType]TaskType{
+	JobHarvest: Harvest,
+	JobBuild:   Build,
+	JobRepair:  Repair,
+	JobCraft:   Craft,
+	JobChop:    Chop,
+	JobMine:    Mine,
+	JobTerrain: Terraform,
+}
+
+func (jt JobType) String() string {
+	switch jt {
+	case JobHaul:
+		return "Haul"
+	case JobHarvest:
+		return "Harvest"
+	case JobBuild:
+		return "Build"
+	case JobRepair:
+		return "Repair"
+	case JobCraft:
+		return "Craft"
+	case JobChop:
+		return "Chop"
+	case JobMine:
+		return "Mine"
+	case JobTerrain:
+		return "Terrain"
+	default:
+		return "Unknown"
+	}
+}
+
+// UpdateJobs removes the jobs which are not needed anymore and posts the new ones
+func (sim *Sim) UpdateJobs() {
+	// claimed jobs are removed by their character when done, see FinishJob
+	jobs := sim.Jobs[:0]
+	for _, job := range sim.Jobs {
+		if job.ClaimedBy != -1 || sim.IsJobNeeded(&job) {
+			jobs = append(jobs, job)
+		}
+	}
+	sim.Jobs = jobs
+
+	// items lying around are brought to storage
+	if sim.FindStorage(TilePosition{}) != nil {
+		sim.ItemManager.ForEach(func(id int32, item *Item) {
+			if item.Location.LocationType == LocTile && item.OwnedBy == -1 && !sim.Reservations.IsItemReserved(id, -1) && !sim.IsInStorage(item.Location.TilePosition) {
+				sim.PostJob(Job{Type: JobHaul, Position: item.Location.TilePosition, ItemID: id})
+			}
+		})
+	}
+	for i := range sim.Fields {
+		for _, position := range sim.Fields[i].GetMatureTiles() {
+			sim.PostJob(Job{Type: JobHarvest, Position: position})
+		}
+	}
+	sim.StructureManager.ForEach(func(id int, s *Structure) {
+		if s.BuildProgress < 100 {
+			if sim.HasConstructionMaterials(s, -1) {
+				sim.PostJob(Job{Type: JobBuild, Position: s.Position, StructureID: s.ID})
+			}
+		} else if s.Condition < config.RepairThreshold {
+			sim.PostJob(Job{Type: JobRepair, Position: s.Position, StructureID: s.ID})
+		}
+	})
+	sim.PostCraftJobs()
+	sim.DesignateTreesForConstruction()
+	if sim.PlantManager != nil {
+		sim.PlantManager.ForEach(func(id int, p *Plant) {
+			if p.ChopDesignated {
+				sim.PostJob(Job{Type: JobChop, Position: p.Position, PlantID: p.ID})
+			}
+		})
+	}
+	sim.PostTerrainJobs()
+}
+
+// PostJob adds a job to the board unless the same job is already there
+func (sim *Sim) PostJob(job Job) {
+	for _, existing := range sim.Jobs {
+		if existing.Type == job.Type && existing.Position == job.Position && existing.ItemID == job.ItemID && existing.StructureID == job.StructureID && existing.BillID == job.BillID {
+			return
+		}
+	}
+	job.ID = sim.NextJobID
+	job.ClaimedBy = -1
+	sim.NextJobID++
+	sim.Jobs = append(sim.Jobs, job)
+}
+
+// IsJobNeeded returns false once a job is done or can't be done anymore
+func (sim *Sim) IsJobNeeded(job *Job) bool {
+	switch job.Type {
+	case JobHaul:
+		item := sim.GetItemPtr(job.ItemID)
+		if item == nil {
+			return false
+		}
+		if item.Location.LocationType == LocCharacter {
+			return item.Location.CharacterID == job.ClaimedBy
+		}
+		return item.Location.LocationType == LocTile && !sim.IsInStorage(item.Location.TilePosition)
+	case JobHarvest:
+		field, index := sim.GetFieldTile(job.Position)
+		return field != nil && field.TileStatus[index].IsMature()
+	case JobBuild:
+		structure := sim.GetStructurePtrByID(job.StructureID)
+		return structure != nil && structure.BuildProgress < 100 && sim.HasConstructionMaterials(structure, job.ClaimedBy)
+	case JobRepair:
+		structure := sim.GetStructurePtrByID(job.StructureID)
+		return structure != nil && structure.Condition < 100
+	case JobCraft:
+		_, bill := sim.GetBill(job.BillID)
+		return bill != nil && sim.IsBillActive(bill)
+	case JobChop:
+		plant := sim.GetPlantAt(job.Position)
+		return plant != nil && plant.ID == job.PlantID && plant.ChopDesignated && IsChoppable(plant)
+	case JobMine, JobTerrain:
+		return sim.IsTerrainJobNeeded(job)
+	}
+	return false
+}
+
+// ContinueHaul points a haul job to what was left behind when the hauler couldn't carry everything
+// it returns false if there is nothing left to haul from the job's tile
+func (sim *Sim) ContinueHaul(job *Job) bool {
+	if job.Type != JobHaul || sim.IsInStorage(job.Position) {
+		return false
+	}
+	for _, itemID := range sim.GetTileAt(job.Position).Items {
+		item := sim.GetItemPtr(itemID)
+		if item == nil || item.OwnedBy != -1 || sim.Reservations.IsItemReserved(itemID, job.ClaimedBy) {
+			continue
+		}
+		hauled := false
+		for i := range sim.Jobs {
+			if sim.Jobs[i].Type == JobHaul && sim.Jobs[i].ItemID == itemID {
+				hauled = true
+				break
+			}
+		}
+		if !hauled {
+			fmt.Printf("Job %d continues with item %d\n", job.ID, itemID)
+			job.ItemID = itemID
+			return true
+		}
+	}
+	return false
+}
+
+// GetWorkPriority returns how much the character wants to do a type of job, 1 is the highest, 0 means never
+func (character *Character) GetWorkPriority(jobType JobType) uint8 {
+	if priority, ok := character.WorkPriorities[jobType]; ok {
+		return priority
+	}
+	return config.DefaultWorkPriority
+}
+
+func (character *Character) SetWorkPriority(jobType JobType, priority uint8) {
+	if character.WorkPriorities == nil {
+		character.WorkPriorities = map[JobType]uint8{}
+	}
+	character.WorkPriorities[jobType] = min(priority, config.MaxWorkPriority)
+}
+
+// GetClaimedJob returns the job claimed by a character, nil if none
+func (sim *Sim) GetClaimedJob(characterID int16) *Job {
+	for i := range sim.Jobs {
+		if sim.Jobs[i].ClaimedBy == characterID {
+			return &sim.Jobs[i]
+		}
+	}
+	return nil
+}
+
+// FindJob returns the available job the character prefers: highest priority first, then the closest
+func (sim *Sim) FindJob(character *Character) *Job {
+	var best *Job
+	var bestPriority uint8
+	bestDistance := -1
+	for i := range sim.Jobs {
+		job := &sim.Jobs[i]
+		priority := character.GetWorkPriority(job.Type)
+		if job.ClaimedBy != -1 || priority == 0 {
+			continue
+		}
+		distance := GetTileDistance(character.TilePosition, job.Position)
+		if best == nil || priority < bestPriority || (priority == bestPriority && distance < bestDistance) {
+			best = job
+			bestPriority = priority
+			bestDistance = distance
+		}
+	}
+	return best
+}
+
+// ClaimJob gives the character its preferred job, nobody else can take it until it's released
+func (sim *Sim) ClaimJob(character *Character) *Job {
+	job := sim.FindJob(character)
+	if job == nil {
+		return nil
+	}
+	job.ClaimedBy = character.ID
+	fmt.Printf("%v claims job %v at %v\n", character.Name, job.Type, job.Position)
+	return job
+}
+
+// ReleaseJob puts the character's job back on the board, e.g. when interrupted
+// a hauled item is dropped where the character stands so someone else can bring it further
+func (sim *Sim) ReleaseJob(character *Character) {
+	job := sim.GetClaimedJob(character.ID)
+	if job == nil {
+		return
+	}
+	if job.Type == JobHaul && slices.Contains(character.Inventory, job.ItemID) {
+		sim.DropItem(character, sim.GetItemPtr(job.ItemID))
+	}
+	job.ClaimedBy = -1
+	fmt.Printf("%v releases job %v\n", character.Name, job.Type)
+}
+
+// FinishJob removes the character's job from the board
+func (sim *Sim) FinishJob(character *Character) {
+	for i := range sim.Jobs {
+		if sim.Jobs[i].ClaimedBy == character.ID {
+			sim.Jobs = append(sim.Jobs[:i], sim.Jobs[i+1:]...)
+			return
+		}
+	}
+}
+
+// ReleaseJobs releases all jobs claimed by a character, e.g. who died
+func (sim *Sim) ReleaseJobs(characterID int16) {
+	for i := range sim.Jobs {
+		if sim.Jobs[i].ClaimedBy == characterID {
+			sim.Jobs[i].ClaimedBy = -1
+		}
+	}
+}
+
+// HasWork returns true if the character has a job or can claim one
+func (sim *Sim) HasWork(character *Character) bool {
+	return sim.GetClaimedJob(character.ID) != nil || sim.FindJob(character) != nil
+}
+
+// Set next task required to do the character's job, claiming one first if needed
+func (sim *Sim) GetNextWorkTask(character *Character, objective *Objective) (task *Task) {
+	job := sim.GetClaimedJob(character.ID)
+	if job == nil {
+		job = sim.ClaimJob(character)
+	}
+	if job == nil {
+		ObjectiveFailed(character, objective)
+		return nil
+	}
+	if taskType, ok := jobTaskTypes[job.Type]; ok {
+		if task = sim.GetNextToolTask(character, objective, taskType); task != nil {
+			return task
+		}
+	}
+	switch job.Type {
+	case JobHaul:
+		item := sim.GetItemPtr(job.ItemID)
+		if item == nil {
+			return nil
+		}
+		if !slices.Contains(character.Inventory, item.ID) {
+			if !item.Location.TilePosition.IsSameAs(character.TilePosition) {
+				return &Task{Objective: objective, Type: Move, TargetTile: &item.Location.TilePosition}
+			}
+			task = &Task{Objective: objective, Type: PickUp, TargetItem: item}
+			sim.ReserveItem(character, task, item)
+			return task
+		}
+		storage := sim.FindStorage(character.TilePosition)
+		if storage == nil {
+			ObjectiveFailed(character, objective)
+			return nil
+		}
+		if !storage.Position.IsSameAs(character.TilePosition) {
+			return &Task{Objective: objective, Type: Move, TargetTile: &storage.Position}
+		}
+		return &Task{Objective: objective, Type: Drop, TargetItem: item}
+	case JobHarvest:
+		if !job.Position.IsSameAs(character.TilePosition) {
+			return &Task{Objective: objective, Type: Move, TargetTile: &job.Position}
+		}
+		task = &Task{Objective: objective, Type: Harvest, TargetTile: &job.Position}
+		sim.ReserveTile(character, task, job.Position)
+		return task
+	case JobBuild, JobRepair:
+		taskType := Build
+		if job.Type == JobRepair {
+			taskType = Repair
+		}
+		if job.Type == JobBuild {
+			if task = sim.GetNextMaterialTask(character, objective, job); task != nil {
+				return task
+			}
+		}
+		if IsAdjacent(character.TilePosition.X, character.TilePosition.Y, job.Position.X, job.Position.Y) {
+			return &Task{Objective: objective, Type: taskType, TargetTile: &job.Position}
+		}
+		// work next to the structure, it may not be walkable
+		path := sim.FindPath(character.TilePosition, job.Position, 1)
+		if len(path) == 0 {
+			ObjectiveFailed(character, objective)
+			return nil
+		}
+		return &Task{Objective: objective, Type: Move, TargetTile: &path[len(path)-1]}
+	case JobCraft:
+		return sim.GetNextCraftingTask(character, objective, job)
+	case JobChop:
+		if !job.Position.IsSameAs(character.TilePosition) {
+			return &Task{Objective: objective, Type: Move, TargetTile: &job.Position}
+		}
+		task = &Task{Objective: objective, Type: Chop, TargetTile: &job.Position}
+		sim.ReserveTile(character, task, job.Position)
+		return task
+	case JobMine, JobTerrain:
+		return sim.GetNextTerrainTask(character, objective, job)
+	}
+	return nil
+}
+
+// GetNextMaterialTask fetches the materials missing on the job's construction site, nil once the character carries them
+// or when there is no more to fetch and what's carried can be delivered
+func (sim *Sim) GetNextMaterialTask(character *Character, objective *Objective, job *Job) *Task {
+	structure := sim.GetStructurePtrByID(job.StructureID)
+	if structure == nil || structure.MaterialsNeeded == 0 {
+		return nil
+	}
+	itemType, variant, _ := GetStructureCost(structure.StructureType)
+	carried := 0
+	for _, item := range sim.GetInventoryItems(character, itemType, variant) {
+		carried += int(item.StackCount)
+	}
+	if carried >= int(structure.MaterialsNeeded) {
+		return nil
+	}
+	item := sim.ScanForItem(character.ID, character.TilePosition, -1, itemType, variant, true)
+	if item == nil || (carried > 0 && sim.GetCarryableCount(character, item) == 0) {
+		return nil
+	}
+	if !item.Location.TilePosition.IsSameAs(character.TilePosition) {
+		return &Task{Objective: objective, Type: Move, TargetTile: &item.Location.TilePosition}
+	}
+	task := &Task{Objective: objective, Type: PickUp, TargetItem: item, Count: structure.MaterialsNeeded - uint8(carried)}
+	sim.ReserveItem(character, task, item)
+	return task
+}
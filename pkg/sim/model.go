@@ -3,25 +3,38 @@ package sim
 type Sim struct {
 	Time             int // in minutes since the start of the simulation
 	Calendar         Calendar
+	Weather          Weather
+	RNG              RNG
 	UI               UIState
 	Player           Player
 	Tiles            []Tile
 	Fields           []Field
+	Rooms            []Room
 	Characters       []Character
+	NextCharacterID  int16 // characters die so IDs are never reused
+	Deceased         []DeceasedCharacter
 	ItemManager      *ItemManager
 	PlantManager     *PlantManager
 	StructureManager *StructureManager
+	Events           []Event
+	Jobs             []Job
+	NextJobID        int32
+	Reservations     ReservationManager
+	NextTaskID       uint64 // tasks get an ID when they reserve something
+	NextBillID       int32
 }
 
 type Tile struct {
-	Type      TileType
-	Position  TilePosition
-	MoveCost  MoveCost
-	Items     []int32
-	Structure int16 // structure id, -1 if no structure
-	Plant     int16 // plant id, -1 if no plant
-	ZoneType  ZoneType
-	ZoneIndex int8
+	Type        TileType
+	Position    TilePosition
+	MoveCost    MoveCost
+	Items       []int32
+	Structure   int16 // structure id, -1 if no structure
+	Plant       int16 // plant id, -1 if no plant
+	ZoneType    ZoneType
+	ZoneIndex   int8
+	Designation TerrainWork // work asked by the player on this tile, see JobMine and JobTerrain
+	Progress    float32     // 0-100, of the designated work, kept when the worker is interrupted
 }
 
 type Field struct {
@@ -31,18 +44,31 @@ type Field struct {
 	TileStatus  []FieldTileStatus
 }
 
+type Room struct {
+	Centroid TilePosition
+	Tiles    []TilePosition
+}
+
 type FieldTileStatus struct {
 	Plowed      bool
 	Seeded      bool
 	Watered     bool
 	GrowthStage uint8 // 0-100
 	SeedVariant int16
+	Quality     float32 // multiplier of the harvest nutrition, from the farming skill of who planted it
 }
 
 type Calendar struct {
 	Minute int8
 	Hour   int8
-	Day    int8
+	Day    int8 // day of the year
+	Year   int16
+}
+
+type Weather struct {
+	Type           WeatherType
+	RemainingTicks int
+	Forced         bool // set from the console, only shown in the UI, it expires like rolled weather
 }
 
 type Item struct {
@@ -50,7 +76,7 @@ type Item struct {
 	Type       ItemType
 	Variant    int16
 	Location   ItemLocation
-	OwnedBy    int8  // character id, -1 if not owned
+	OwnedBy    int16 // character id, -1 if not owned
 	Efficiency uint8 // for food it's nutrition value
 	Durability uint8
 	StackCount uint8 // some items can be stacked, e.g. seeds or materials
@@ -59,20 +85,51 @@ type Item struct {
 type ItemLocation struct {
 	LocationType ItemLocationType
 	TilePosition TilePosition
-	CharacterID  int8
+	CharacterID  int16
 }
 
 type Character struct {
-	ID            int8
-	Name          string
-	WorldPosition WorldPosition
-	TilePosition  TilePosition
-	Path          []TilePosition
-	Needs         Needs
-	CurrentTask   *Task
-	Objectives    []Objective
-	Ambitions     []Ambition
-	Inventory     []int32 // Object IDs
+	ID              int16
+	Name            string
+	BirthDay        int8 // day of the year
+	BirthYear       int16
+	LifeStage       LifeStage
+	PartnerID       int16 // -1 if single
+	ParentIDs       []int16
+	ChildrenIDs     []int16
+	Opinions        map[int16]float32 // character id -> opinion of them, -100 to 100
+	Backstory       string
+	Traits          []TraitType
+	WorldPosition   WorldPosition
+	TilePosition    TilePosition
+	Path            []TilePosition
+	Needs           Needs
+	NeedModifiers   map[NeedType]float32 // per character multipliers of the need decay rates
+	Health          int8                 // 0-100
+	Injuries        []Injury
+	LastDamageCause DamageCause
+	Mood            float32 // 0-100
+	Thoughts        []Thought
+	Schedule        Schedule
+	Skills          map[SkillType]Skill
+	WorkPriorities  map[JobType]uint8 // 1 is the highest priority, 0 means never, see GetWorkPriority
+	CurrentTask     *Task
+	ActiveObjective ObjectiveType // type of the objective last pursued, favored when scoring objectives
+	Objectives      []Objective
+	Ambitions       []Ambition
+	Inventory       []int32                 // Object IDs
+	Equipment       map[EquipmentSlot]int32 // item IDs, equipped items are also in the inventory
+}
+
+// DeceasedCharacter keeps what's needed to display family trees after a character died
+type DeceasedCharacter struct {
+	ID        int16
+	Name      string
+	BirthYear int16
+	Death     Calendar
+	Cause     DamageCause
+	PartnerID int16
+	ParentIDs []int16
 }
 
 type WorldPosition struct {
@@ -89,45 +146,91 @@ type Player struct {
 	WorldPosition WorldPosition
 }
 
-type Needs struct {
-	Food  int8
-	Water int8
-	Sleep int8
+// Needs maps each need to its current value, 0 is fully satisfied
+type Needs map[NeedType]float32
+
+type Injury struct {
+	Cause    DamageCause
+	Severity uint8 // health lost when injured, heals over time in a bed
+}
+
+type Skill struct {
+	Level      uint8 // 0-MaxSkillLevel
+	Experience float32
+}
+
+// Thought is a timed mood modifier, e.g. "Slept on the ground"
+type Thought struct {
+	Type      ThoughtType
+	ExpiresAt int // sim time
+}
+
+type Event struct {
+	Time        int
+	Calendar    Calendar
+	Type        EventType
+	CharacterID int16 // -1 if not related to a character
+	Description string
 }
 
 type Task struct {
-	ID             uint64
-	Type           TaskType
-	Objective      *Objective
-	Progress       float32       // by default, 0 to 1, as percent of task already done, but can be used otherwise like for movement
-	ProductType    int           // optional, precises the task is producing based on the Task Type, for example for bulding tasks it's the StructureType to build (e.g. Wall)
-	ProductVariant int16         // optional, further precises the task's product by providing a variant (e.g. Wooden Wall, Stone Wall)
-	TargetItem     *Item         // optional, e.g. for eating tasks it's the food item to eat
-	TargetTile     *TilePosition // optional, e.g. for building it's the tile ot build on
-	MaterialSource *Item         // optional, e.g. for building tasks it's the material item to use, for planting it's the seed...
-	Count          uint8         // optional, general field, e.g. for a pick up task how many items to get
+	ID              uint64
+	Type            TaskType
+	Objective       *Objective
+	Progress        float32       // by default, 0 to 1, as percent of task already done, but can be used otherwise like for movement
+	ProductType     int           // optional, precises the task is producing based on the Task Type, for example for bulding tasks it's the StructureType to build (e.g. Wall)
+	ProductVariant  int16         // optional, further precises the task's product by providing a variant (e.g. Wooden Wall, Stone Wall)
+	TargetItem      *Item         // optional, e.g. for eating tasks it's the food item to eat
+	TargetTile      *TilePosition // optional, e.g. for building it's the tile ot build on
+	MaterialSource  *Item         // optional, e.g. for building tasks it's the material item to use, for planting it's the seed...
+	Count           uint8         // optional, general field, e.g. for a pick up task how many items to get
+	TargetCharacter int16         // optional, e.g. for chatting it's the other character
+}
+
+type Job struct {
+	ID          int32
+	Type        JobType
+	Position    TilePosition // where the work is, e.g. the item to haul or the structure to build
+	ItemID      int32        // optional, the item to haul
+	StructureID int16        // optional, the structure to build, repair or craft at
+	BillID      int32        // optional, the bill to craft
+	PlantID     int16        // optional, the tree to chop
+	ClaimedBy   int16        // character id, -1 if available
 }
 
 type Objective struct {
-	Type    ObjectiveType
-	Variant int16 // optional, further precises the objective by providing a variant (e.g. "build a house")
-	Stuck   bool
-	Plan    []Task // optional, sometimes we pre-plan list of tasks as the objective is defined
+	Type              ObjectiveType
+	Variant           int16 // optional, further precises the objective by providing a variant (e.g. "build a house")
+	Stuck             bool
+	Score             float32          // utility score when objectives were last compared
+	Plan              []Task           // optional, sometimes we pre-plan list of tasks as the objective is defined
+	Suspended         *Task            // task interrupted by a more important objective, resumed when possible
+	Actions           []GoapActionType // actions planned to reach the objective's goal, see PlanActions
+	Distance          int              // distance to the resource needed when objectives were last compared, see GetObjectiveDistance
+	DistanceExpiresAt int              // time from which Distance is scanned again
 }
 
 type Ambition struct {
+	Type        AmbitionType
+	Variant     int16   // optional, e.g. the skill to master or the food to stockpile
+	Target      int     // optional, e.g. the skill level or the amount of food to reach
+	StructureID int16   // optional, e.g. the bed being built for a house, -1 if none
+	Progress    float32 // 0 to 1
+	Completed   bool
 	Description string
 }
 
 // Plants grow and can produce edible or craft materials (fruits, wood, etc.)
 type Plant struct {
-	ID          int16
-	Position    TilePosition
-	PlantType   PlantType
-	Variant     int16
-	GrowthStage uint8 // 0-100
-	GrowthRate  uint8 // How many growth stages per update
-	Produces    Production
+	ID             int16
+	Position       TilePosition
+	PlantType      PlantType
+	Variant        int16
+	GrowthStage    uint8  // 0-100
+	GrowthRate     uint8  // How many growth stages per update
+	Age            uint16 // in days
+	ChopDesignated bool   // to be felled for wood, see JobChop
+	Produces       Production
 }
 
 type Production struct {
@@ -138,10 +241,22 @@ type Production struct {
 }
 
 type Structure struct {
-	ID            int16
-	Position      TilePosition
-	StructureType StructureType
-	Condition     uint8 // 0-100
-	Owner         int8  // character id, -1 if not owned
-	BuildProgress uint8 // 0-100
+	ID              int16
+	Position        TilePosition
+	StructureType   StructureType
+	Condition       uint8  // 0-100
+	Owner           int16  // character id, -1 if not owned
+	BuildProgress   uint8  // 0-100
+	Bills           []Bill // recipes queued by the player, done in order
+	MaterialsNeeded uint8  // units still to be brought before building, see GetStructureCost
+}
+
+// Bill asks for a recipe to be made at a structure, see data/recipes.json
+type Bill struct {
+	ID       int32
+	RecipeID int
+	Mode     BillMode
+	Target   int // how many times to make the recipe, or how many of its output to keep in stock
+	Done     int
+	Progress float32 // 0-100, work done on the current one, kept when the crafter is interrupted
 }
@@ -30,7 +30,13 @@ type UIState struct {
 	EditorPlantVariant     int16
 	EditorStructureType    StructureType
 	SelectedTileIndex      int
-	SelectedCharacterIndex int8
+	SelectedCharacterIndex int16
 	SelectedPlantIndex     int16
 	SelectedStructureIndex int16
+	ShowScheduleEditor     bool
+	ScheduleEditorHour     int8 // hour of the day selected in the schedule editor
+	ShowWorkPriorities     bool
+	WorkPrioritiesRow      int // character row selected in the work priorities panel
+	WorkPrioritiesColumn   int // job type column selected in the work priorities panel
+	ShowReservations       bool
 }
@@ -0,0 +1,132 @@
+package sim
+
+import (
+	"fmt"
+	"gociv/pkg/config"
+	"gociv/pkg/data"
+)
+
+// Thoughts are defined in data/thoughts.json
+type ThoughtType int
+
+const (
+	ThoughtAteRawFood ThoughtType = iota
+	ThoughtAteMeal
+	ThoughtSleptOnGround
+	ThoughtColonistDied
+	ThoughtInjured
+	ThoughtTookWalk
+	ThoughtBirthday
+	ThoughtHadChild
+	ThoughtFoundPartner
+	ThoughtFamilyDied
+	ThoughtNiceChat
+	ThoughtArgument
+	ThoughtAteWithFriend
+	ThoughtAchievedAmbition
+	ThoughtAteRottenFood
+)
+
+func (tt ThoughtType) String() string {
+	if def, ok := data.GetThoughtDefinition(int(tt)); ok {
+		return def.Name
+	}
+	return fmt.Sprintf("Thought %d", int(tt))
+}
+
+// MoodModifier is one line of the mood breakdown, e.g. "Hungry -10"
+type MoodModifier struct {
+	Label string
+	Value float32
+}
+
+// AddThought gives a timed mood modifier to a character, the same thought refreshes its duration instead of stacking
+func (sim *Sim) AddThought(character *Character, thoughtType ThoughtType) {
+	def, ok := data.GetThoughtDefinition(int(thoughtType))
+	if !ok {
+		fmt.Printf("Unknown thought %d\n", thoughtType)
+		return
+	}
+	expiresAt := sim.Time + def.Duration
+	for i := range character.Thoughts {
+		if character.Thoughts[i].Type == thoughtType {
+			character.Thoughts[i].ExpiresAt = expiresAt
+			return
+		}
+	}
+	character.Thoughts = append(character.Thoughts, Thought{Type: thoughtType, ExpiresAt: expiresAt})
+}
+
+// GetMoodModifiers returns everything currently affecting the character's mood
+func (sim *Sim) GetMoodModifiers(character *Character) []MoodModifier {
+	var modifiers []MoodModifier
+	for _, def := range data.NeedDefinitions {
+		needType := NeedType(def.NeedType)
+		if character.IsNeedCritical(needType) {
+			modifiers = append(modifiers, MoodModifier{Label: "Needs " + def.Name, Value: -config.MoodCriticalNeedPenalty})
+		} else if character.IsNeedSatisfied(needType) {
+			modifiers = append(modifiers, MoodModifier{Label: def.Name + " satisfied", Value: config.MoodSatisfiedNeedBonus})
+		}
+	}
+	for _, trait := range character.Traits {
+		if def, ok := data.GetTraitDefinition(int(trait)); ok && def.Mood != 0 {
+			modifiers = append(modifiers, MoodModifier{Label: def.Name, Value: def.Mood})
+		}
+	}
+	if quality := sim.GetRoomQuality(character.TilePosition); quality != 0 {
+		modifiers = append(modifiers, MoodModifier{Label: "Room", Value: quality})
+	}
+	for _, thought := range character.Thoughts {
+		if def, ok := data.GetThoughtDefinition(int(thought.Type)); ok {
+			modifiers = append(modifiers, MoodModifier{Label: def.Name, Value: def.Mood})
+		}
+	}
+	return modifiers
+}
+
+// UpdateMood expires old thoughts and moves the mood toward the sum of its modifiers
+func (sim *Sim) UpdateMood(character *Character) {
+	for i := len(character.Thoughts) - 1; i >= 0; i-- {
+		if character.Thoughts[i].ExpiresAt <= sim.Time {
+			character.Thoughts = append(character.Thoughts[:i], character.Thoughts[i+1:]...)
+		}
+	}
+
+	target := float32(config.BaseMood)
+	for _, modifier := range sim.GetMoodModifiers(character) {
+		target += modifier.Value
+	}
+	target = max(0, min(target, 100))
+
+	// mood changes gradually
+	if character.Mood < target {
+		character.Mood = min(character.Mood+config.MoodChangeRate, target)
+	} else if character.Mood > target {
+		character.Mood = max(character.Mood-config.MoodChangeRate, target)
+	}
+}
+
+// GetRoomQuality returns the mood bonus of being on a tile: rooms are nicer than outdoor, more with furniture
+func (sim *Sim) GetRoomQuality(position TilePosition) float32 {
+	tile := sim.GetTileAt(position)
+	if tile.ZoneType != ZoneTypeRoom || int(tile.ZoneIndex) >= len(sim.Rooms) {
+		return 0
+	}
+	quality := float32(config.RoomMoodBonus)
+	furniture := 0
+	for _, roomTile := range sim.Rooms[tile.ZoneIndex].Tiles {
+		if structureID := sim.GetTileAt(roomTile).Structure; structureID != -1 {
+			switch sim.GetStructureByID(structureID).StructureType {
+			case Bed, Furniture, Fireplace:
+				furniture++
+			}
+		}
+	}
+	quality += min(float32(furniture)*config.FurnitureMoodBonus, config.MaxFurnitureMoodBonus)
+	return quality
+}
+
+// HasLowMood returns true when the character is unhappy enough to refuse work and wander around
+func (character *Character) HasLowMood() bool {
+	return character.Mood < config.MoodBreakThreshold
+}
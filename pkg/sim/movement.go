@@ -87,8 +87,11 @@ func (sim *Sim) Move(c *Character, deltaTime float32) {
 		Y: dy / distanceSqrt,
 	}
 
-	// Calculate movement this frame
+	// Calculate movement this frame, difficult terrain (e.g. snow) slows characters down
 	moveDistance := CHARACTER_SPEED * deltaTime
+	if moveCost := sim.GetTileAt(nextTile).MoveCost; moveCost > 0 {
+		moveDistance /= float32(moveCost)
+	}
 	remainingDistance := distanceSqrt
 
 	// If we would overshoot, snap to target instead
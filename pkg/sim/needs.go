@@ -0,0 +1,88 @@
+package sim
+
+import (
+	"fmt"
+	"gociv/pkg/config"
+	"gociv/pkg/data"
+)
+
+// Needs are defined in data/needs.json, these constants are only for needs the sim handles specifically
+type NeedType int
+
+const (
+	NeedFood NeedType = iota
+	NeedWater
+	NeedSleep
+	NeedWarmth
+	NeedSocial
+)
+
+func (nt NeedType) String() string {
+	if def, ok := data.GetNeedDefinition(int(nt)); ok {
+		return def.Name
+	}
+	return fmt.Sprintf("Need %d", int(nt))
+}
+
+// UpdateNeeds raises all needs by their decay rate, scaled by the character's modifiers
+func (character *Character) UpdateNeeds() {
+	for _, def := range data.NeedDefinitions {
+		if def.DecayRate == 0 {
+			continue
+		}
+		needType := NeedType(def.NeedType)
+		character.ChangeNeed(needType, def.DecayRate*character.GetNeedModifier(needType))
+	}
+}
+
+func (character *Character) GetNeed(needType NeedType) float32 {
+	return character.Needs[needType]
+}
+
+// ChangeNeed adds delta to a need, keeping it between 0 and config.NeedCap
+func (character *Character) ChangeNeed(needType NeedType, delta float32) {
+	if character.Needs == nil {
+		character.Needs = make(Needs)
+	}
+	character.Needs[needType] = max(0, min(character.Needs[needType]+delta, config.NeedCap))
+}
+
+// GetNeedModifier returns the per character multiplier of a need decay rate, 1 by default
+func (character *Character) GetNeedModifier(needType NeedType) float32 {
+	if modifier, ok := character.NeedModifiers[needType]; ok {
+		return modifier
+	}
+	return 1
+}
+
+// IsNeedCritical returns true when the need should trigger its objective
+func (character *Character) IsNeedCritical(needType NeedType) bool {
+	def, ok := data.GetNeedDefinition(int(needType))
+	return ok && character.GetNeed(needType) >= def.Critical
+}
+
+// IsNeedSatisfied returns true when the need's objective can be considered achieved
+func (character *Character) IsNeedSatisfied(needType NeedType) bool {
+	def, ok := data.GetNeedDefinition(int(needType))
+	return !ok || character.GetNeed(needType) < def.Satisfied
+}
+
+// HasCriticalNeed returns true if any need other than sleep is critical
+func (character *Character) HasCriticalNeed() bool {
+	for _, def := range data.NeedDefinitions {
+		if NeedType(def.NeedType) != NeedSleep && def.Objective != 0 && character.IsNeedCritical(NeedType(def.NeedType)) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetObjectiveNeed returns the need an objective type satisfies, if any
+func GetObjectiveNeed(objectiveType ObjectiveType) (NeedType, bool) {
+	for _, def := range data.NeedDefinitions {
+		if def.Objective != 0 && ObjectiveType(def.Objective) == objectiveType {
+			return NeedType(def.NeedType), true
+		}
+	}
+	return 0, false
+}
@@ -3,18 +3,25 @@ package sim
 import (
 	"fmt"
 	"gociv/pkg/config"
+	"gociv/pkg/data"
 )
 
 type ObjectiveType int
 
-// Sorted by priority (lowest number is highest priority)
+// Objectives are picked by utility, see ScoreObjective
+// the values are referenced in data/needs.json so new types are added at the end
 const (
 	NoObjective ObjectiveType = iota
 	DrinkObjective
 	EatObjective
+	ShelterObjective
+	WarmthObjective
 	SleepObjective
 	MakeFoodObjective
 	BuildObjective
+	WanderObjective
+	SocializeObjective
+	WorkObjective
 )
 
 func (ot ObjectiveType) String() string {
@@ -25,16 +32,32 @@ func (ot ObjectiveType) String() string {
 		return "Drink"
 	case EatObjective:
 		return "Eat"
+	case ShelterObjective:
+		return "Shelter"
+	case WarmthObjective:
+		return "Warm up"
 	case SleepObjective:
 		return "Sleep"
 	case MakeFoodObjective:
 		return "Make Food"
 	case BuildObjective:
 		return "Build"
+	case WanderObjective:
+		return "Wander"
+	case SocializeObjective:
+		return "Socialize"
+	case WorkObjective:
+		return "Work"
 	}
 	return "Unknown"
 }
 
+// IsWork returns true for objectives which are not about the character's own needs
+// unhappy characters refuse them
+func (ot ObjectiveType) IsWork() bool {
+	return ot == MakeFoodObjective || ot == BuildObjective || ot == WorkObjective
+}
+
 func (sim *Sim) UpdateObjectives(character *Character) {
 	// periodically un-stuck all objectives to try again
 	if sim.Time%config.CharacterObjectiveResetInterval == 0 {
@@ -43,21 +66,48 @@ func (sim *Sim) UpdateObjectives(character *Character) {
 		}
 	}
 
-	if character.Needs.Food >= config.NeedFoodMax && !character.HasObjective(EatObjective) {
-		sim.AddObjective(character, EatObjective, 0)
+	// each need triggers its objective when critical, and drops it once satisfied by other means
+	for _, def := range data.NeedDefinitions {
+		objectiveType := ObjectiveType(def.Objective)
+		if objectiveType == NoObjective {
+			continue
+		}
+		if character.IsNeedCritical(NeedType(def.NeedType)) && !character.HasObjective(objectiveType) {
+			sim.AddObjective(character, objectiveType, 0)
+		} else if character.IsNeedSatisfied(NeedType(def.NeedType)) && character.HasObjective(objectiveType) && !character.IsPursuing(objectiveType) {
+			character.CompleteObjective(&Objective{Type: objectiveType})
+		}
 	}
 
-	if character.Needs.Water >= config.NeedWaterMax && !character.HasObjective(DrinkObjective) {
-		sim.AddObjective(character, DrinkObjective, 0)
+	// storms push characters indoors
+	if sim.Weather.Type == Storm {
+		if !character.HasObjective(ShelterObjective) && !sim.GetTileAt(character.TilePosition).IsSheltered() {
+			sim.AddObjective(character, ShelterObjective, 0)
+		}
+	} else if character.HasObjective(ShelterObjective) && !character.IsPursuing(ShelterObjective) {
+		character.CompleteObjective(&Objective{Type: ShelterObjective})
 	}
 
-	if character.Needs.Sleep >= config.NeedSleepMax && !character.HasObjective(SleepObjective) {
+	if character.IsNeedCritical(NeedFood) && sim.GetCropCount() == 0 && !character.HasObjective(MakeFoodObjective) {
+		sim.AddObjective(character, MakeFoodObjective, 0)
+	}
+
+	// go to bed at night even if not exhausted
+	block := character.GetScheduleBlock(sim.Calendar.Hour)
+	if block == ScheduleSleep && !character.IsNeedSatisfied(NeedSleep) && !character.HasObjective(SleepObjective) {
 		sim.AddObjective(character, SleepObjective, 0)
 	}
 
-	if character.Needs.Food >= config.NeedFoodMax && sim.GetGrowingTilesCount() == 0 && !character.HasObjective(MakeFoodObjective) {
-		sim.AddObjective(character, MakeFoodObjective, 0)
+	// jobs are taken from the board one at a time
+	if character.LifeStage != Child && !character.HasObjective(WorkObjective) && sim.HasWork(character) {
+		sim.AddObjective(character, WorkObjective, 0)
 	}
+
+	if character.HasLowMood() && !character.HasObjective(WanderObjective) {
+		sim.AddObjective(character, WanderObjective, 0)
+	}
+
+	sim.HelpFriends(character)
 }
 
 func (sim *Sim) AddObjective(character *Character, objectiveType ObjectiveType, variant int16) (createdObjective Objective) {
@@ -66,7 +116,10 @@ func (sim *Sim) AddObjective(character *Character, objectiveType ObjectiveType,
 		Variant: variant,
 		Plan:    []Task{},
 	}
+	current := character.getTaskObjectiveKey()
 	character.Objectives = append(character.Objectives, objective)
+	// appending may reallocate the slice
+	character.restoreTaskObjective(current)
 	return objective
 }
 
@@ -79,50 +132,99 @@ func (character *Character) HasObjective(objectiveType ObjectiveType) bool {
 	return false
 }
 
+// IsPursuing returns true if the current task is for an objective of this type
+func (character *Character) IsPursuing(objectiveType ObjectiveType) bool {
+	return character.CurrentTask != nil && character.CurrentTask.Objective != nil && character.CurrentTask.Objective.Type == objectiveType
+}
+
+// CompleteObjective removes all objectives of the same type and variant
+// removing shifts the Objectives slice so the current task is pointed back to its objective
 func (character *Character) CompleteObjective(objective *Objective) {
-	fmt.Printf("Completing objective %v %v\n", character.Name, objective.Type)
+	// the objective may point into the slice, copy what identifies it before removing anything
+	objectiveType, variant := objective.Type, objective.Variant
+	fmt.Printf("Completing objective %v %v\n", character.Name, objectiveType)
+	if character.ActiveObjective == objectiveType {
+		character.ActiveObjective = NoObjective
+	}
+	current := character.getTaskObjectiveKey()
 	for i := len(character.Objectives) - 1; i >= 0; i-- {
 		charObjective := character.Objectives[i]
-		if charObjective.Type == objective.Type && charObjective.Variant == objective.Variant {
+		if charObjective.Type == objectiveType && charObjective.Variant == variant {
 			character.Objectives = append(character.Objectives[:i], character.Objectives[i+1:]...)
 		}
 	}
+	character.restoreTaskObjective(current)
+}
+
+// getTaskObjectiveKey copies the type and variant of the current task's objective, nil if none
+func (character *Character) getTaskObjectiveKey() *Objective {
+	if character.CurrentTask == nil || character.CurrentTask.Objective == nil {
+		return nil
+	}
+	return &Objective{Type: character.CurrentTask.Objective.Type, Variant: character.CurrentTask.Objective.Variant}
+}
+
+// restoreTaskObjective points the current task back to its objective after the Objectives slice changed
+// if the objective was removed the task is left without one, it's finished without achieving anything
+func (character *Character) restoreTaskObjective(key *Objective) {
+	if key == nil {
+		return
+	}
+	for i := range character.Objectives {
+		if character.Objectives[i].Type == key.Type && character.Objectives[i].Variant == key.Variant {
+			character.CurrentTask.Objective = &character.Objectives[i]
+			return
+		}
+	}
+	character.CurrentTask.Objective = nil
 }
 
 func (sim *Sim) CheckIfObjectiveIsAchieved(character *Character, objective *Objective) {
-	switch objective.Type {
-	case EatObjective:
-		if character.Needs.Food < 40 {
+	// objectives triggered by a need are achieved once it's satisfied
+	if needType, ok := GetObjectiveNeed(objective.Type); ok {
+		if character.IsNeedSatisfied(needType) {
 			character.CompleteObjective(objective)
 		}
-	case DrinkObjective:
-		if character.Needs.Water < 40 {
+		return
+	}
+	switch objective.Type {
+	case ShelterObjective:
+		if sim.Weather.Type != Storm || sim.GetTileAt(character.TilePosition).IsSheltered() {
 			character.CompleteObjective(objective)
 		}
-	case SleepObjective:
-		if character.Needs.Sleep < 10 {
+	case MakeFoodObjective:
+		if sim.GetCropCount() >= config.PlantSeedsAtLeast {
 			character.CompleteObjective(objective)
 		}
-	case MakeFoodObjective:
-		if sim.GetGrowingTilesCount() >= config.PlantSeedsAtLeast {
+	case WorkObjective:
+		// one job at a time, the objective is added again while there is work
+		if job := sim.GetClaimedJob(character.ID); job == nil || (!sim.IsJobNeeded(job) && !sim.ContinueHaul(job)) {
+			sim.FinishJob(character)
 			character.CompleteObjective(objective)
 		}
+	case WanderObjective:
+		// one walk at a time, it's added again if the character is still unhappy
+		sim.AddThought(character, ThoughtTookWalk)
+		character.CompleteObjective(objective)
 	}
 }
 
-// Get the top non-stuck priority objective (lowest ObjectiveType is highest priority)
+// Get the non-stuck objective with the highest utility score, see ScoreObjective
+// scores are kept on the objectives for debugging
 func (sim *Sim) GetTopPriorityObjective(character *Character) *Objective {
-	if len(character.Objectives) == 0 {
-		return nil
-	}
-	lowestIndex := -1
+	bestIndex := -1
 	for i := range character.Objectives {
-		if !character.Objectives[i].Stuck && (lowestIndex == -1 || character.Objectives[i].Type < character.Objectives[lowestIndex].Type) {
-			lowestIndex = i
+		objective := &character.Objectives[i]
+		objective.Score = sim.ScoreObjective(character, objective)
+		if objective.Stuck || objective.Score <= 0 {
+			continue
+		}
+		if bestIndex == -1 || objective.Score > character.Objectives[bestIndex].Score {
+			bestIndex = i
 		}
 	}
-	if lowestIndex == -1 {
+	if bestIndex == -1 {
 		return nil
 	}
-	return &character.Objectives[lowestIndex]
+	return &character.Objectives[bestIndex]
 }
@@ -0,0 +1,213 @@
+package sim
+
+import (
+	"fmt"
+	"gociv/pkg/config"
+	"maps"
+	"slices"
+)
+
+// CanPlanAhead returns false for objectives whose targets move, e.g. a chat partner
+// their plan only holds the next task
+func (ot ObjectiveType) CanPlanAhead() bool {
+	return ot != SocializeObjective && ot != WanderObjective
+}
+
+// BuildPlan lists all the tasks needed to achieve an objective, e.g. move → pick up seed → move → plant
+// it chains the GetNext*Task functions on a copy of the character which is moved and given items as each task would
+// planning only changes the world through what the plan needs to hold: reservations (released with the plan),
+// the claimed job of a work objective and the objective being marked stuck
+func (sim *Sim) BuildPlan(character *Character, objective *Objective) []Task {
+	if goal, ok := objective.Type.GetGoalState(); ok {
+		return sim.buildGoalPlan(character, objective, goal)
+	}
+	planner := newPlanner(character)
+	plan := []Task{}
+	for len(plan) < config.MaxPlanSteps {
+		task := sim.CreateNextTask(&planner, objective)
+		if task == nil {
+			break
+		}
+		if task.Type == Move && task.TargetTile != nil && task.TargetTile.IsSameAs(planner.TilePosition) {
+			// already there, nothing left to plan
+			sim.ReleaseTaskReservations(task)
+			break
+		}
+		// plan steps get their objective when they're started, a task pointing to its own objective can't be saved
+		task.Objective = nil
+		plan = append(plan, *task)
+		if !objective.Type.CanPlanAhead() || !sim.applyPlannedTask(&planner, task) {
+			break
+		}
+	}
+	if objective.Stuck {
+		// a later step can't be done so neither can the plan
+		sim.releasePlanReservations(character, plan)
+		return []Task{}
+	}
+	return plan
+}
+
+// newPlanner copies the character for planning, what the planner changes doesn't reach the real character
+func newPlanner(character *Character) Character {
+	planner := *character
+	planner.Path = nil
+	planner.Inventory = slices.Clone(character.Inventory)
+	planner.Objectives = slices.Clone(character.Objectives)
+	planner.Needs = maps.Clone(character.Needs)
+	planner.Skills = maps.Clone(character.Skills)
+	planner.WorkPriorities = maps.Clone(character.WorkPriorities)
+	planner.Equipment = maps.Clone(character.Equipment)
+	return planner
+}
+
+// applyPlannedTask changes the planner as if the task was done
+// it returns false for tasks whose outcome ends the plan, e.g. eating
+func (sim *Sim) applyPlannedTask(planner *Character, task *Task) bool {
+	switch task.Type {
+	case Move:
+		planner.TilePosition = *task.TargetTile
+		return true
+	case PickUp:
+		planner.Inventory = append(planner.Inventory, task.TargetItem.ID)
+		return true
+	}
+	return false
+}
+
+// GetNextPlannedTask returns the next step of the objective's plan, building the plan if needed
+// the plan is built again when its next step is not valid anymore, a new plan whose first step isn't valid gets the objective stuck
+func (sim *Sim) GetNextPlannedTask(character *Character, objective *Objective) *Task {
+	if len(objective.Plan) > 0 && !sim.IsTaskValid(character, &objective.Plan[0]) {
+		fmt.Printf("%v's plan for %v is not valid anymore at %v, replanning\n", character.Name, objective.Type, objective.Plan[0].Type)
+		sim.ClearPlan(character, objective)
+	}
+	if len(objective.Plan) == 0 {
+		objective.Plan = sim.BuildPlan(character, objective)
+		if len(objective.Plan) == 0 {
+			return nil
+		}
+		fmt.Printf("%v planned %v steps for %v\n", character.Name, len(objective.Plan), objective.Type)
+		if !sim.IsTaskValid(character, &objective.Plan[0]) {
+			fmt.Printf("%v's new plan for %v can't start with %v\n", character.Name, objective.Type, objective.Plan[0].Type)
+			sim.ClearPlan(character, objective)
+			if objective.Type == WorkObjective {
+				// someone else may be able to do it
+				sim.ReleaseJob(character)
+			}
+			ObjectiveFailed(character, objective)
+			return nil
+		}
+	}
+	task := objective.Plan[0]
+	objective.Plan = objective.Plan[1:]
+	task.Objective = objective
+	return &task
+}
+
+// ClearPlan drops the remaining steps of a plan and their reservations
+func (sim *Sim) ClearPlan(character *Character, objective *Objective) {
+	sim.releasePlanReservations(character, objective.Plan)
+	objective.Plan = []Task{}
+	objective.Actions = nil
+}
+
+func (sim *Sim) releasePlanReservations(character *Character, plan []Task) {
+	for i := range plan {
+		sim.ReleaseTaskReservations(&plan[i])
+	}
+}
+
+// IsTaskValid checks the preconditions of a task before it's started or resumed
+func (sim *Sim) IsTaskValid(character *Character, task *Task) bool {
+	// item pointers move when the item manager grows, get them again
+	if task.TargetItem != nil {
+		if task.TargetItem = sim.refreshItem(task.TargetItem); task.TargetItem == nil {
+			return false
+		}
+	}
+	if task.MaterialSource != nil {
+		if task.MaterialSource = sim.refreshItem(task.MaterialSource); task.MaterialSource == nil {
+			return false
+		}
+	}
+	switch task.Type {
+	case Move:
+		return task.TargetTile != nil && sim.GetTileAt(*task.TargetTile).MoveCost != ImpassableCost
+	case PickUp:
+		item := task.TargetItem
+		return item != nil && item.Location.LocationType == LocTile && sim.IsItemAvailable(character.ID, item) &&
+			IsAdjacent(character.TilePosition.X, character.TilePosition.Y, item.Location.TilePosition.X, item.Location.TilePosition.Y)
+	case Eat:
+		item := task.TargetItem
+		if item == nil {
+			return false
+		}
+		if item.Location.LocationType == LocCharacter {
+			return item.Location.CharacterID == character.ID
+		}
+		return sim.IsItemAvailable(character.ID, item) && item.Location.TilePosition.IsSameAs(character.TilePosition)
+	case Drink:
+		return task.TargetTile != nil && IsAdjacent(character.TilePosition.X, character.TilePosition.Y, task.TargetTile.X, task.TargetTile.Y)
+	case PlantSeed:
+		seed := task.MaterialSource
+		if seed == nil || seed.Location.LocationType != LocCharacter || seed.Location.CharacterID != character.ID {
+			return false
+		}
+		if task.TargetTile == nil || !task.TargetTile.IsSameAs(character.TilePosition) {
+			return false
+		}
+		if sim.Reservations.IsTileReserved(*task.TargetTile, character.ID) {
+			return false
+		}
+		// free dirt becomes a field when planting starts, see PlantSeed
+		if sim.GetTileAt(*task.TargetTile).IsFreeForField() {
+			return true
+		}
+		field, index := sim.GetFieldTile(*task.TargetTile)
+		return field != nil && !field.TileStatus[index].Seeded
+	case Harvest:
+		if task.TargetTile == nil || !task.TargetTile.IsSameAs(character.TilePosition) || sim.Reservations.IsTileReserved(*task.TargetTile, character.ID) {
+			return false
+		}
+		field, index := sim.GetFieldTile(*task.TargetTile)
+		return field != nil && field.TileStatus[index].IsMature()
+	case WaitForCrop:
+		if task.TargetTile == nil {
+			return false
+		}
+		field, index := sim.GetFieldTile(*task.TargetTile)
+		return field != nil && field.TileStatus[index].Seeded
+	case GatherSeeds:
+		return task.TargetTile != nil && task.TargetTile.IsSameAs(character.TilePosition) && sim.GetPlantSeeds(sim.GetPlantAt(*task.TargetTile)) != nil
+	case Chop:
+		return task.TargetTile != nil && task.TargetTile.IsSameAs(character.TilePosition) && IsChoppable(sim.GetPlantAt(*task.TargetTile)) &&
+			!sim.Reservations.IsTileReserved(*task.TargetTile, character.ID)
+	case Mine, Terraform:
+		return task.TargetTile != nil && IsAdjacent(character.TilePosition.X, character.TilePosition.Y, task.TargetTile.X, task.TargetTile.Y) &&
+			sim.GetTileAt(*task.TargetTile).Designation != TerrainNone && !sim.Reservations.IsTileReserved(*task.TargetTile, character.ID)
+	case WarmUp:
+		return sim.IsWarmTile(character.TilePosition)
+	case Drop, Equip:
+		return task.TargetItem != nil && slices.Contains(character.Inventory, task.TargetItem.ID)
+	case Build, Repair:
+		return task.TargetTile != nil && sim.GetStructureAt(*task.TargetTile) != nil &&
+			IsAdjacent(character.TilePosition.X, character.TilePosition.Y, task.TargetTile.X, task.TargetTile.Y)
+	case Craft:
+		if task.TargetTile == nil || !IsAdjacent(character.TilePosition.X, character.TilePosition.Y, task.TargetTile.X, task.TargetTile.Y) {
+			return false
+		}
+		structure := sim.GetStructureAt(*task.TargetTile)
+		return structure != nil && !sim.Reservations.IsStructureReserved(structure.ID, character.ID)
+	}
+	return true
+}
+
+// refreshItem returns the current pointer to an item, nil if it was removed or replaced by another one
+func (sim *Sim) refreshItem(item *Item) *Item {
+	current := sim.GetItemPtr(item.ID)
+	if current == nil || current.Type != item.Type || current.Variant != item.Variant {
+		return nil
+	}
+	return current
+}
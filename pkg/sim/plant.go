@@ -18,6 +18,7 @@ func (sim *Sim) UpdatePlants() {
 	sim.PlantManager.ForEach(func(id int, p *Plant) {
 		sim.Update(p)
 	})
+	sim.UpdatePlantLifecycle()
 }
 
 func (sim *Sim) SpawnPlant(position TilePosition, variant int16, plantType PlantType) int16 {
@@ -38,8 +39,22 @@ func (sim *Sim) SpawnPlant(position TilePosition, variant int16, plantType Plant
 }
 
 func (sim *Sim) Update(plant *Plant) {
+	def, ok := data.GetPlantDefinition(int(plant.PlantType), plant.Variant)
+	if !ok || sim.IsPlantDormant(plant) {
+		return
+	}
+	growth, ok := GetTerrainGrowth(def, sim.GetTileAt(plant.Position).Type)
+	if !ok {
+		return
+	}
 	if plant.GrowthStage < 100 {
-		plant.GrowthStage += plant.GrowthRate
+		// fractional growth is rounded randomly so slow terrains still grow on average
+		rate := float32(plant.GrowthRate) * growth
+		whole := uint8(rate)
+		if sim.RNG.Chance(rate - float32(whole)) {
+			whole++
+		}
+		plant.GrowthStage = min(plant.GrowthStage+whole, 100)
 	}
 	if plant.GrowthStage >= 100 && plant.Produces.ProductionStage <= 100 && plant.Produces.Type != ItemTypeNone {
 		plant.Produces.ProductionStage += plant.Produces.ProductionRate
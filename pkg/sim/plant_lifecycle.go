@@ -0,0 +1,83 @@
+package sim
+
+import (
+	"fmt"
+	"gociv/pkg/config"
+	"gociv/pkg/data"
+	"slices"
+)
+
+// Plants age every day, grown plants seed the tiles around them and old ones die, see data/plants.json
+
+// GetTerrainGrowth returns the growth multiplier of a plant on a tile type, false if it doesn't grow there
+// plants without terrain preferences grow on any walkable ground
+func GetTerrainGrowth(def *data.PlantDefinition, tileType TileType) (float32, bool) {
+	if len(def.Terrains) == 0 {
+		return 1, tileType != TileTypeWall && tileType != TileTypeWater
+	}
+	growth, ok := def.Terrains[int(tileType)]
+	return growth, ok
+}
+
+// IsPlantDormant returns true during the seasons a plant rests, it neither grows nor produces
+func (sim *Sim) IsPlantDormant(plant *Plant) bool {
+	def, ok := data.GetPlantDefinition(int(plant.PlantType), plant.Variant)
+	return ok && slices.Contains(def.DormantSeasons, int(sim.Calendar.Season()))
+}
+
+// UpdatePlantLifecycle ages plants at midnight, old plants may die and grown ones spread
+func (sim *Sim) UpdatePlantLifecycle() {
+	if sim.Calendar.Hour != 0 || sim.Calendar.Minute != 0 {
+		return
+	}
+	// plants are removed and added once the manager isn't iterated anymore
+	var dead []int16
+	var seedlings []Plant
+	sim.PlantManager.ForEach(func(id int, p *Plant) {
+		p.Age++
+		def, ok := data.GetPlantDefinition(int(p.PlantType), p.Variant)
+		if !ok {
+			return
+		}
+		if def.MaxAge > 0 && p.Age >= def.MaxAge && sim.RNG.Chance(config.PlantOldAgeDeathChance) {
+			dead = append(dead, p.ID)
+			return
+		}
+		if p.GrowthStage < 100 || sim.IsPlantDormant(p) || !sim.RNG.Chance(def.SpreadChance) {
+			return
+		}
+		position := TilePosition{
+			X: p.Position.X + int16(sim.RNG.IntRange(-def.SpreadRadius, def.SpreadRadius)),
+			Y: p.Position.Y + int16(sim.RNG.IntRange(-def.SpreadRadius, def.SpreadRadius)),
+		}
+		if sim.CanPlantGrowAt(def, position) && !slices.ContainsFunc(seedlings, func(s Plant) bool { return s.Position == position }) {
+			seedlings = append(seedlings, Plant{PlantType: p.PlantType, Variant: p.Variant, Position: position})
+		}
+	})
+	for _, id := range dead {
+		if plant := sim.GetPlantByID(id); plant != nil {
+			fmt.Printf("Plant %d died of old age at %v\n", id, plant.Position)
+			sim.RemovePlant(id)
+		}
+	}
+	for _, seedling := range seedlings {
+		if sim.PlantManager.Count() >= config.MaxPlants {
+			break
+		}
+		sim.SpawnPlant(seedling.Position, seedling.Variant, seedling.PlantType)
+		fmt.Printf("Plant spread to %v\n", seedling.Position)
+	}
+}
+
+// CanPlantGrowAt returns true if a wild plant can take root on a tile: free, not farmed and of a terrain it likes
+func (sim *Sim) CanPlantGrowAt(def *data.PlantDefinition, position TilePosition) bool {
+	if position.X < 0 || position.X >= config.RegionSize || position.Y < 0 || position.Y >= config.RegionSize {
+		return false
+	}
+	tile := sim.GetTileAt(position)
+	if tile.Plant != -1 || tile.Structure != -1 || tile.ZoneType == ZoneTypeField || tile.Designation != TerrainNone {
+		return false
+	}
+	_, ok := GetTerrainGrowth(def, tile.Type)
+	return ok
+}
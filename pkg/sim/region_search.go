@@ -5,10 +5,17 @@ import "gociv/pkg/config"
 // ScanForTile searches the closest reachable tile of a given terrain type using BFS
 // Only explores passable tiles, so it respects walls and obstacles
 func (sim *Sim) ScanForTile(position TilePosition, maxDistance int, terrain TileType) *TilePosition {
+	return sim.ScanForTileMatching(position, maxDistance, func(tile *Tile) bool {
+		return tile.Type == terrain
+	})
+}
+
+// ScanForTileMatching searches the closest reachable tile for which match returns true using BFS
+func (sim *Sim) ScanForTileMatching(position TilePosition, maxDistance int, match func(tile *Tile) bool) *TilePosition {
 	// Check current tile first
 	if position.X >= 0 && position.X < config.RegionSize && position.Y >= 0 && position.Y < config.RegionSize {
 		tile := sim.GetTileAt(position)
-		if tile.Type == terrain {
+		if match(tile) {
 			return &position
 		}
 	}
@@ -56,9 +63,9 @@ func (sim *Sim) ScanForTile(position TilePosition, maxDistance int, terrain Tile
 				neighborPos := TilePosition{X: newX, Y: newY}
 				queue = append(queue, neighborPos)
 
-				// Check if this tile matches the terrain type
+				// Check if this tile matches
 				tile := sim.GetTileAt(neighborPos)
-				if tile.Type == terrain {
+				if match(tile) {
 					return &neighborPos
 				}
 			}
@@ -0,0 +1,157 @@
+package sim
+
+import (
+	"fmt"
+	"gociv/pkg/config"
+)
+
+// Reservations keep an item, structure or tile for the task of a character, so others don't race for it
+// unlike ownership they only last as long as the task, see ReleaseTaskReservations
+type ReservationType uint8
+
+const (
+	ReserveItem ReservationType = iota
+	ReserveStructure
+	ReserveTile
+)
+
+func (rt ReservationType) String() string {
+	switch rt {
+	case ReserveItem:
+		return "Item"
+	case ReserveStructure:
+		return "Structure"
+	case ReserveTile:
+		return "Tile"
+	default:
+		return "Unknown"
+	}
+}
+
+type Reservation struct {
+	Type        ReservationType
+	ItemID      int32
+	StructureID int16
+	Tile        TilePosition
+	CharacterID int16
+	TaskID      uint64
+	ExpiresAt   int // sim time after which the reservation is dropped, in case its task is lost
+}
+
+type ReservationManager struct {
+	Reservations []Reservation
+}
+
+func (rm *ReservationManager) add(reservation Reservation) {
+	rm.Reservations = append(rm.Reservations, reservation)
+}
+
+// release removes all reservations matching a condition
+func (rm *ReservationManager) release(match func(r *Reservation) bool) {
+	kept := rm.Reservations[:0]
+	for i := range rm.Reservations {
+		if !match(&rm.Reservations[i]) {
+			kept = append(kept, rm.Reservations[i])
+		}
+	}
+	rm.Reservations = kept
+}
+
+// isReserved returns true if a reservation matches and belongs to another character
+func (rm *ReservationManager) isReserved(characterID int16, match func(r *Reservation) bool) bool {
+	for i := range rm.Reservations {
+		if rm.Reservations[i].CharacterID != characterID && match(&rm.Reservations[i]) {
+			return true
+		}
+	}
+	return false
+}
+
+func (rm *ReservationManager) IsItemReserved(itemID int32, characterID int16) bool {
+	return rm.isReserved(characterID, func(r *Reservation) bool { return r.Type == ReserveItem && r.ItemID == itemID })
+}
+
+func (rm *ReservationManager) IsStructureReserved(structureID int16, characterID int16) bool {
+	return rm.isReserved(characterID, func(r *Reservation) bool { return r.Type == ReserveStructure && r.StructureID == structureID })
+}
+
+func (rm *ReservationManager) IsTileReserved(position TilePosition, characterID int16) bool {
+	return rm.isReserved(characterID, func(r *Reservation) bool { return r.Type == ReserveTile && r.Tile == position })
+}
+
+// reserve ties a reservation to a task, giving the task an ID if it has none yet
+func (sim *Sim) reserve(character *Character, task *Task, reservation Reservation) {
+	if task.ID == 0 {
+		sim.NextTaskID++
+		task.ID = sim.NextTaskID
+	}
+	reservation.CharacterID = character.ID
+	reservation.TaskID = task.ID
+	reservation.ExpiresAt = sim.Time + config.ReservationTimeout
+	sim.Reservations.add(reservation)
+}
+
+func (sim *Sim) ReserveItem(character *Character, task *Task, item *Item) {
+	sim.reserve(character, task, Reservation{Type: ReserveItem, ItemID: item.ID})
+}
+
+func (sim *Sim) ReserveStructure(character *Character, task *Task, structure *Structure) {
+	sim.reserve(character, task, Reservation{Type: ReserveStructure, StructureID: structure.ID})
+}
+
+func (sim *Sim) ReserveTile(character *Character, task *Task, position TilePosition) {
+	sim.reserve(character, task, Reservation{Type: ReserveTile, Tile: position})
+}
+
+// ReleaseTaskReservations is called when a task is completed, cancelled or interrupted
+func (sim *Sim) ReleaseTaskReservations(task *Task) {
+	if task.ID == 0 {
+		return
+	}
+	sim.Reservations.release(func(r *Reservation) bool { return r.TaskID == task.ID })
+}
+
+// ReleaseCharacterReservations is called when a character dies
+func (sim *Sim) ReleaseCharacterReservations(characterID int16) {
+	sim.Reservations.release(func(r *Reservation) bool { return r.CharacterID == characterID })
+}
+
+// UpdateReservations drops the reservations which timed out
+func (sim *Sim) UpdateReservations() {
+	sim.Reservations.release(func(r *Reservation) bool {
+		if r.ExpiresAt <= sim.Time {
+			fmt.Printf("Reservation of %v by %d timed out\n", r.Type, r.CharacterID)
+			return true
+		}
+		return false
+	})
+}
+
+// IsItemAvailable returns true if the character can use the item: not owned nor reserved by someone else
+func (sim *Sim) IsItemAvailable(characterID int16, item *Item) bool {
+	return (item.OwnedBy == -1 || item.OwnedBy == characterID) && !sim.Reservations.IsItemReserved(item.ID, characterID)
+}
+
+// GetReservationPosition returns the tile of what is reserved, false if it doesn't exist anymore
+func (sim *Sim) GetReservationPosition(reservation *Reservation) (TilePosition, bool) {
+	switch reservation.Type {
+	case ReserveItem:
+		item := sim.GetItemPtr(reservation.ItemID)
+		if item == nil {
+			return TilePosition{}, false
+		}
+		if item.Location.LocationType == LocCharacter {
+			if character := sim.GetCharacterByID(item.Location.CharacterID); character != nil {
+				return character.TilePosition, true
+			}
+			return TilePosition{}, false
+		}
+		return item.Location.TilePosition, true
+	case ReserveStructure:
+		if structure := sim.GetStructurePtrByID(reservation.StructureID); structure != nil {
+			return structure.Position, true
+		}
+		return TilePosition{}, false
+	}
+	return reservation.Tile, true
+}
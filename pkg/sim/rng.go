@@ -0,0 +1,46 @@
+package sim
+
+// RNG is a small deterministic random generator (splitmix64) owned by the sim.
+// Its state is exported so it is persisted in saves and random events can be replayed.
+type RNG struct {
+	State uint64
+}
+
+func NewRNG(seed uint64) RNG {
+	return RNG{State: seed}
+}
+
+// Uint64 returns the next pseudo-random number
+func (r *RNG) Uint64() uint64 {
+	r.State += 0x9E3779B97F4A7C15
+	z := r.State
+	z = (z ^ (z >> 30)) * 0xBF58476D1CE4E5B9
+	z = (z ^ (z >> 27)) * 0x94D049BB133111EB
+	return z ^ (z >> 31)
+}
+
+// Intn returns a pseudo-random number in [0, n), or 0 if n <= 0
+func (r *RNG) Intn(n int) int {
+	if n <= 0 {
+		return 0
+	}
+	return int(r.Uint64() % uint64(n))
+}
+
+// IntRange returns a pseudo-random number in [min, max]
+func (r *RNG) IntRange(min, max int) int {
+	if max <= min {
+		return min
+	}
+	return min + r.Intn(max-min+1)
+}
+
+// Float32 returns a pseudo-random number in [0, 1)
+func (r *RNG) Float32() float32 {
+	return float32(r.Uint64()>>40) / float32(1<<24)
+}
+
+// Chance returns true with the given probability (0 to 1)
+func (r *RNG) Chance(probability float32) bool {
+	return r.Float32() < probability
+}
@@ -0,0 +1,107 @@
+package sim
+
+import (
+	"gociv/pkg/config"
+	"gociv/pkg/data"
+)
+
+type ScheduleBlock int
+
+// Leisure is the zero value: no preference
+const (
+	ScheduleLeisure ScheduleBlock = iota
+	ScheduleWork
+	ScheduleSleep
+)
+
+func (sb ScheduleBlock) String() string {
+	switch sb {
+	case ScheduleLeisure:
+		return "Leisure"
+	case ScheduleWork:
+		return "Work"
+	case ScheduleSleep:
+		return "Sleep"
+	default:
+		return "Unknown"
+	}
+}
+
+// Schedule holds the activity of a character for each hour of the day
+type Schedule [24]ScheduleBlock
+
+// DefaultSchedule sleeps from 22:00 to 6:00 and works from 8:00 to 18:00
+func DefaultSchedule() Schedule {
+	var schedule Schedule
+	for hour := range schedule {
+		switch {
+		case hour < config.WakeUpHour || hour >= config.BedTimeHour:
+			schedule[hour] = ScheduleSleep
+		case hour >= config.WorkStartHour && hour < config.WorkEndHour:
+			schedule[hour] = ScheduleWork
+		default:
+			schedule[hour] = ScheduleLeisure
+		}
+	}
+	return schedule
+}
+
+// Shift moves the whole schedule by a number of hours, negative is earlier
+func (schedule Schedule) Shift(hours int) Schedule {
+	var shifted Schedule
+	for hour := range schedule {
+		shifted[((hour+hours)%24+24)%24] = schedule[hour]
+	}
+	return shifted
+}
+
+// ResetSchedule sets the default schedule, shifted by the character's traits
+func (character *Character) ResetSchedule() {
+	character.Schedule = DefaultSchedule()
+	for _, trait := range character.Traits {
+		if def, ok := data.GetTraitDefinition(int(trait)); ok && def.ScheduleShift != 0 {
+			character.Schedule = character.Schedule.Shift(def.ScheduleShift)
+		}
+	}
+}
+
+// GetScheduleBlock returns what the character should be doing at a given hour
+func (character *Character) GetScheduleBlock(hour int8) ScheduleBlock {
+	return character.Schedule[int(hour)%24]
+}
+
+// How much each schedule block favors objectives, objectives not listed have a weight of 1
+// a weight of 0 means the objective is not pursued during the block
+// weights are kept small enough for work never to come before survival needs
+var scheduleWeights = map[ScheduleBlock]map[ObjectiveType]float32{
+	ScheduleSleep: {
+		SleepObjective:     1.5,
+		MakeFoodObjective:  0,
+		BuildObjective:     0,
+		WorkObjective:      0,
+		SocializeObjective: 0.5,
+		WanderObjective:    0.5,
+	},
+	ScheduleWork: {
+		MakeFoodObjective:  1.1,
+		BuildObjective:     1.1,
+		WorkObjective:      1.1,
+		SocializeObjective: 0.5,
+		WanderObjective:    0.5,
+	},
+	ScheduleLeisure: {
+		MakeFoodObjective:  0.5,
+		BuildObjective:     0.5,
+		WorkObjective:      0.5,
+		SocializeObjective: 1.5,
+		WanderObjective:    1.5,
+	},
+}
+
+// GetScheduleWeight returns how much the active schedule block favors an objective
+func GetScheduleWeight(block ScheduleBlock, objectiveType ObjectiveType) float32 {
+	if weight, ok := scheduleWeights[block][objectiveType]; ok {
+		return weight
+	}
+	return 1
+}
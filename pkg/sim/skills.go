@@ -0,0 +1,126 @@
+package sim
+
+import (
+	"fmt"
+	"gociv/pkg/config"
+)
+
+type SkillType int
+
+const (
+	SkillFarming SkillType = iota
+	SkillConstruction
+	SkillCooking
+	SkillHauling
+	SkillMining
+)
+
+// Skills lists all skill types in display order
+var Skills = []SkillType{SkillFarming, SkillConstruction, SkillCooking, SkillHauling, SkillMining}
+
+func (st SkillType) String() string {
+	switch st {
+	case SkillFarming:
+		return "Farming"
+	case SkillConstruction:
+		return "Construction"
+	case SkillCooking:
+		return "Cooking"
+	case SkillHauling:
+		return "Hauling"
+	case SkillMining:
+		return "Mining"
+	default:
+		return "Unknown"
+	}
+}
+
+// Skill trained by performing each task type, tasks not in the map don't train anything
+// picking up and dropping are instant so hauling doesn't speed them up, it raises the carry capacity instead, see GetCarryCapacity
+var taskSkills = map[TaskType]SkillType{
+	PickUp:      SkillHauling,
+	Drop:        SkillHauling,
+	Build:       SkillConstruction,
+	Repair:      SkillConstruction,
+	PlantSeed:   SkillFarming,
+	Harvest:     SkillFarming,
+	GatherSeeds: SkillFarming,
+	Chop:        SkillFarming,
+	Mine:        SkillMining,
+	Terraform:   SkillConstruction,
+}
+
+// GetTaskSkill returns the skill used by a task type
+func GetTaskSkill(taskType TaskType) (SkillType, bool) {
+	skill, ok := taskSkills[taskType]
+	return skill, ok
+}
+
+func (character *Character) GetSkillLevel(skillType SkillType) uint8 {
+	return character.Skills[skillType].Level
+}
+
+// GetTaskSpeed returns the multiplier of the progress made per tick on a task
+func (character *Character) GetTaskSpeed(taskType TaskType) float32 {
+	skillType, ok := GetTaskSkill(taskType)
+	if !ok {
+		return character.GetWorkSpeed()
+	}
+	return character.GetSkillSpeed(skillType)
+}
+
+// GetSkillSpeed returns the multiplier of the progress made per tick on work using a skill, e.g. a recipe
+func (character *Character) GetSkillSpeed(skillType SkillType) float32 {
+	return (1 + float32(character.GetSkillLevel(skillType))*config.SkillSpeedPerLevel) * character.GetWorkSpeed()
+}
+
+// GetTaskQuality returns the multiplier applied to the output of a task, e.g. nutrition of harvested food
+func (character *Character) GetTaskQuality(taskType TaskType) float32 {
+	skillType, ok := GetTaskSkill(taskType)
+	if !ok {
+		return 1
+	}
+	return character.GetSkillQuality(skillType)
+}
+
+func (character *Character) GetSkillQuality(skillType SkillType) float32 {
+	return 1 + float32(character.GetSkillLevel(skillType))*config.SkillQualityPerLevel
+}
+
+// TrainSkill gives experience in the skill used by a task type, called for each tick of work
+func (character *Character) TrainSkill(taskType TaskType) {
+	skillType, ok := GetTaskSkill(taskType)
+	if !ok {
+		return
+	}
+	character.PracticeSkill(skillType)
+}
+
+// PracticeSkill gives the experience of one tick of work in a skill
+func (character *Character) PracticeSkill(skillType SkillType) {
+	character.GainExperience(skillType, config.SkillExperiencePerTick*character.GetSkillGainModifier(skillType))
+}
+
+// GainExperience adds experience to a skill and levels it up when the next level is reached
+func (character *Character) GainExperience(skillType SkillType, experience float32) {
+	if character.Skills == nil {
+		character.Skills = map[SkillType]Skill{}
+	}
+	skill := character.Skills[skillType]
+	if skill.Level >= config.MaxSkillLevel {
+		return
+	}
+	skill.Experience += experience
+	for skill.Level < config.MaxSkillLevel && skill.Experience >= GetExperienceForNextLevel(skill.Level) {
+		skill.Experience -= GetExperienceForNextLevel(skill.Level)
+		skill.Level++
+		fmt.Printf("%v reached level %d in %v\n", character.Name, skill.Level, skillType)
+	}
+	character.Skills[skillType] = skill
+}
+
+// GetExperienceForNextLevel returns the experience needed to go from a level to the next
+// higher levels take longer to reach
+func GetExperienceForNextLevel(level uint8) float32 {
+	return float32(level+1) * config.SkillExperiencePerLevel
+}
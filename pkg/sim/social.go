@@ -0,0 +1,217 @@
+package sim
+
+import (
+	"fmt"
+	"gociv/pkg/config"
+	"gociv/pkg/data"
+)
+
+// GetOpinion returns what the character thinks of another character, 0 if they never met
+func (character *Character) GetOpinion(otherID int16) float32 {
+	return character.Opinions[otherID]
+}
+
+// ChangeOpinion changes the character's opinion of another character, clamped to -100..100
+func (character *Character) ChangeOpinion(otherID int16, amount float32) {
+	if character.Opinions == nil {
+		character.Opinions = map[int16]float32{}
+	}
+	character.Opinions[otherID] = max(-100, min(character.GetOpinion(otherID)+amount, 100))
+}
+
+func (character *Character) IsFriendOf(otherID int16) bool {
+	return character.PartnerID == otherID || character.GetOpinion(otherID) >= config.FriendOpinion
+}
+
+// GetRelationshipLabel describes the relationship to another character for the UI
+func (character *Character) GetRelationshipLabel(otherID int16) string {
+	opinion := character.GetOpinion(otherID)
+	switch {
+	case character.PartnerID == otherID:
+		return "Partner"
+	case opinion >= config.FriendOpinion:
+		return "Friend"
+	case opinion <= config.RivalOpinion:
+		return "Rival"
+	default:
+		return "Acquaintance"
+	}
+}
+
+// Set next task required to socialize: go to the closest character available to chat and chat with them
+func (sim *Sim) GetNextSocializingTask(character *Character, objective *Objective) (task *Task) {
+	other := sim.FindChatPartner(character)
+	if other == nil {
+		ObjectiveFailed(character, objective)
+		return nil
+	}
+	if !IsAdjacent(character.TilePosition.X, character.TilePosition.Y, other.TilePosition.X, other.TilePosition.Y) {
+		target := other.TilePosition
+		return &Task{
+			Objective:  objective,
+			Type:       Move,
+			TargetTile: &target,
+		}
+	}
+
+	// the other character joins when the conversation starts, see Chat
+	return &Task{
+		Objective:       objective,
+		Type:            Chat,
+		TargetCharacter: other.ID,
+	}
+}
+
+// FindChatPartner returns the best available character to chat with: close and well liked
+func (sim *Sim) FindChatPartner(character *Character) *Character {
+	var best *Character
+	var bestScore float32
+	for i := range sim.Characters {
+		other := &sim.Characters[i]
+		if other.ID == character.ID || !other.IsAvailableToChat() {
+			continue
+		}
+		distance := GetTileDistance(character.TilePosition, other.TilePosition)
+		score := float32(distance) - character.GetOpinion(other.ID)/10
+		if best == nil || score < bestScore {
+			best = other
+			bestScore = score
+		}
+	}
+	return best
+}
+
+// IsAvailableToChat returns true when the character is idle or only wandering or socializing
+func (character *Character) IsAvailableToChat() bool {
+	task := character.CurrentTask
+	if task == nil {
+		return true
+	}
+	if task.Type == Chat || task.Objective == nil {
+		return false
+	}
+	return task.Objective.Type == WanderObjective || task.Objective.Type == SocializeObjective
+}
+
+func (sim *Sim) Chat(character *Character) {
+	task := character.CurrentTask
+	other := sim.GetCharacterByID(task.TargetCharacter)
+	if task.Progress == 0 && other != nil {
+		sim.JoinChat(character, other)
+	}
+	if other == nil || other.CurrentTask == nil || other.CurrentTask.Type != Chat || other.CurrentTask.TargetCharacter != character.ID ||
+		!IsAdjacent(character.TilePosition.X, character.TilePosition.Y, other.TilePosition.X, other.TilePosition.Y) {
+		fmt.Printf("%v's conversation was interrupted\n", character.Name)
+		sim.CancelTask(character)
+		return
+	}
+	task.Progress += config.ChatProgress
+	character.ChangeNeed(NeedSocial, -config.ChatSocialRecovery)
+	if task.Progress >= 100 {
+		// both characters end the conversation at the same time
+		sim.EndChat(character, other)
+		sim.EndChat(other, character)
+		sim.CompleteTask(other)
+	}
+}
+
+// JoinChat makes the other character chat with the one starting the conversation, if they're still available
+func (sim *Sim) JoinChat(character *Character, other *Character) {
+	if other.CurrentTask != nil && other.CurrentTask.Type == Chat && other.CurrentTask.TargetCharacter == character.ID {
+		return
+	}
+	if !other.IsAvailableToChat() || !IsAdjacent(character.TilePosition.X, character.TilePosition.Y, other.TilePosition.X, other.TilePosition.Y) {
+		return
+	}
+	if other.CurrentTask != nil {
+		sim.CancelTask(other)
+	}
+	if !other.HasObjective(SocializeObjective) {
+		sim.AddObjective(other, SocializeObjective, 0)
+	}
+	for i := range other.Objectives {
+		if other.Objectives[i].Type == SocializeObjective {
+			other.CurrentTask = &Task{
+				Objective:       &other.Objectives[i],
+				Type:            Chat,
+				TargetCharacter: character.ID,
+			}
+			break
+		}
+	}
+	fmt.Printf("%v starts chatting with %v\n", character.Name, other.Name)
+}
+
+// EndChat changes the character's opinion of the other based on their traits, conversations sometimes go wrong
+func (sim *Sim) EndChat(character *Character, other *Character) {
+	change := float32(config.ChatOpinionGain)
+	for _, trait := range character.Traits {
+		if other.HasTrait(trait) {
+			change += config.SharedTraitOpinion
+		}
+		if def, ok := data.GetTraitDefinition(int(trait)); ok {
+			for _, excluded := range def.Excludes {
+				if other.HasTrait(TraitType(excluded)) {
+					change += config.ClashingTraitOpinion
+				}
+			}
+		}
+	}
+	if sim.RNG.Chance(config.ArgumentChance) {
+		change += config.ArgumentOpinion
+	}
+	character.ChangeOpinion(other.ID, change)
+	if change >= 0 {
+		sim.AddThought(character, ThoughtNiceChat)
+	} else {
+		sim.AddThought(character, ThoughtArgument)
+	}
+	fmt.Printf("%v chatted with %v, opinion %+.0f -> %.0f\n", character.Name, other.Name, change, character.GetOpinion(other.ID))
+}
+
+// FindEatingFriend returns a friend currently eating near the character, or nil
+func (sim *Sim) FindEatingFriend(character *Character) *Character {
+	for i := range sim.Characters {
+		other := &sim.Characters[i]
+		if other.ID == character.ID || !character.IsFriendOf(other.ID) || other.CurrentTask == nil || other.CurrentTask.Type != Eat {
+			continue
+		}
+		if GetTileDistance(character.TilePosition, other.TilePosition) <= config.EatTogetherRadius {
+			return other
+		}
+	}
+	return nil
+}
+
+// GetSharedBed returns the bed of the character's partner or of a friend who likes them back, or nil
+func (sim *Sim) GetSharedBed(character *Character) *Structure {
+	for i := range sim.Characters {
+		other := &sim.Characters[i]
+		if other.ID == character.ID || !character.IsFriendOf(other.ID) || !other.IsFriendOf(character.ID) {
+			continue
+		}
+		if beds := sim.StructureManager.GetStructuresByOwnerAndType(other.ID, Bed); len(beds) > 0 {
+			return beds[0]
+		}
+	}
+	return nil
+}
+
+// HelpFriends makes the character join the work of a friend, e.g. making food
+func (sim *Sim) HelpFriends(character *Character) {
+	if character.HasLowMood() || character.LifeStage == Child {
+		return
+	}
+	for i := range sim.Characters {
+		other := &sim.Characters[i]
+		if other.ID == character.ID || !character.IsFriendOf(other.ID) {
+			continue
+		}
+		for _, objective := range other.Objectives {
+			if objective.Type.IsWork() && !objective.Stuck && !character.HasObjective(objective.Type) {
+				fmt.Printf("%v helps %v: %v\n", character.Name, other.Name, objective.Type)
+				sim.AddObjective(character, objective.Type, objective.Variant)
+			}
+		}
+	}
+}
@@ -1,5 +1,10 @@
 package sim
 
+import (
+	"gociv/pkg/config"
+	"strings"
+)
+
 type StructureType int
 
 const (
@@ -8,8 +13,38 @@ const (
 	Furniture
 	Workshop
 	Storage
+	Fireplace
 )
 
+func (st StructureType) String() string {
+	switch st {
+	case Well:
+		return "Well"
+	case Bed:
+		return "Bed"
+	case Furniture:
+		return "Furniture"
+	case Workshop:
+		return "Workshop"
+	case Storage:
+		return "Storage"
+	case Fireplace:
+		return "Fireplace"
+	default:
+		return "Unknown"
+	}
+}
+
+// ParseStructureType returns the structure type with the given name, case insensitive
+func ParseStructureType(name string) (StructureType, bool) {
+	for st := Well; st <= Fireplace; st++ {
+		if strings.EqualFold(st.String(), name) {
+			return st, true
+		}
+	}
+	return Well, false
+}
+
 func (sim *Sim) SpawnStructure(position TilePosition, structureType StructureType) int16 {
 	newStructure := Structure{
 		Position:      position,
@@ -20,3 +55,81 @@ func (sim *Sim) SpawnStructure(position TilePosition, structureType StructureTyp
 	}
 	return sim.AddStructure(newStructure)
 }
+
+// Material brought to a construction site before it's built
+type StructureCost struct {
+	Type    ItemType
+	Variant int16
+	Count   uint8
+}
+
+var structureCosts = map[StructureType]StructureCost{
+	Bed:       {ItemTypeMaterial, MaterialWood, 4},
+	Furniture: {ItemTypeMaterial, MaterialWood, 3},
+	Workshop:  {ItemTypeMaterial, MaterialWood, 6},
+	Storage:   {ItemTypeMaterial, MaterialWood, 2},
+	Fireplace: {ItemTypeMaterial, MaterialStone, 3},
+}
+
+// GetStructureCost returns the material needed to build a structure type, a count of 0 if it's free
+func GetStructureCost(structureType StructureType) (ItemType, int16, uint8) {
+	cost := structureCosts[structureType]
+	return cost.Type, cost.Variant, cost.Count
+}
+
+// PlaceConstructionSite adds a structure which has to be built by characters, see JobBuild
+func (sim *Sim) PlaceConstructionSite(position TilePosition, structureType StructureType) int16 {
+	_, _, count := GetStructureCost(structureType)
+	return sim.AddStructure(Structure{
+		Position:        position,
+		StructureType:   structureType,
+		Owner:           -1,
+		MaterialsNeeded: count,
+	})
+}
+
+// HasConstructionMaterials returns true if a construction site has its materials, or some can be brought to it
+// by the character or anyone if characterID is -1
+func (sim *Sim) HasConstructionMaterials(structure *Structure, characterID int16) bool {
+	if structure.MaterialsNeeded == 0 {
+		return true
+	}
+	itemType, variant, _ := GetStructureCost(structure.StructureType)
+	if character := sim.GetCharacterByID(characterID); character != nil && len(sim.GetInventoryItems(character, itemType, variant)) > 0 {
+		return true
+	}
+	return sim.CountStoredItems(itemType, variant) > 0
+}
+
+// UpdateStructures wears built structures down every day, they have to be repaired
+func (sim *Sim) UpdateStructures() {
+	if sim.Calendar.Hour != 0 || sim.Calendar.Minute != 0 {
+		return
+	}
+	sim.StructureManager.ForEach(func(id int, s *Structure) {
+		if s.BuildProgress >= 100 {
+			s.Condition -= min(s.Condition, config.StructureWearPerDay)
+		}
+	})
+}
+
+// FindStorage returns the closest built storage, nil if there is none
+func (sim *Sim) FindStorage(position TilePosition) *Structure {
+	var closest *Structure
+	closestDistance := -1
+	sim.StructureManager.ForEach(func(id int, s *Structure) {
+		if s.StructureType != Storage || s.BuildProgress < 100 {
+			return
+		}
+		if distance := GetTileDistance(position, s.Position); closestDistance == -1 || distance < closestDistance {
+			closest = s
+			closestDistance = distance
+		}
+	})
+	return closest
+}
+
+func (sim *Sim) IsInStorage(position TilePosition) bool {
+	structure := sim.GetStructureAt(position)
+	return structure != nil && structure.StructureType == Storage && structure.BuildProgress >= 100
+}
@@ -106,7 +106,7 @@ func (sm *StructureManager) GetStructurePtr(id int16) (*Structure, bool) {
 }
 
 // GetStructuresByOwnerAndType returns a slice of structure pointers matching the given owner ID and structure type.
-func (sm *StructureManager) GetStructuresByOwnerAndType(ownerID int8, structureType StructureType) []*Structure {
+func (sm *StructureManager) GetStructuresByOwnerAndType(ownerID int16, structureType StructureType) []*Structure {
 	result := make([]*Structure, 0)
 	for id := range sm.structures {
 		if sm.usedSlots[id] {
@@ -8,8 +8,8 @@ import "gociv/pkg/config"
 // Sentinel values:
 // - structureType: pass StructureType(-1) for any
 // - variant: pass -1 for any
-// - if unclaimedOnly is true, only returns structures that are unowned (-1) or owned by characterID
-func (sim *Sim) ScanForStructure(characterID int8, position TilePosition, maxDistance int, structureType StructureType, variant int, unclaimedOnly bool) *Structure {
+// - if unclaimedOnly is true, only returns structures that are unowned (-1) or owned by characterID, and not reserved by others
+func (sim *Sim) ScanForStructure(characterID int16, position TilePosition, maxDistance int, structureType StructureType, variant int, unclaimedOnly bool) *Structure {
 	// Check current tile first
 	if position.X >= 0 && position.X < config.RegionSize && position.Y >= 0 && position.Y < config.RegionSize {
 		if s := sim.FindStructureInTile(characterID, position, structureType, variant, unclaimedOnly); s != nil {
@@ -76,7 +76,7 @@ func (sim *Sim) ScanForStructure(characterID int8, position TilePosition, maxDis
 	return nil
 }
 
-func (sim *Sim) FindStructureInTile(characterID int8, position TilePosition, structureType StructureType, variant int, unclaimedOnly bool) *Structure {
+func (sim *Sim) FindStructureInTile(characterID int16, position TilePosition, structureType StructureType, variant int, unclaimedOnly bool) *Structure {
 	if sim.StructureManager == nil {
 		return nil
 	}
@@ -95,7 +95,7 @@ func (sim *Sim) FindStructureInTile(characterID int8, position TilePosition, str
 		if !anyType && s.StructureType != structureType {
 			continue
 		}
-		if unclaimedOnly && s.Owner != -1 && s.Owner != characterID {
+		if unclaimedOnly && ((s.Owner != -1 && s.Owner != characterID) || sim.Reservations.IsStructureReserved(s.ID, characterID)) {
 			continue
 		}
 		return s
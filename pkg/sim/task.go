@@ -14,6 +14,19 @@ const (
 	Sleep
 	PickUp
 	PlantSeed
+	WarmUp
+	Chat
+	Harvest
+	GatherSeeds
+	WaitForCrop
+	Drop
+	Build
+	Repair
+	Craft
+	Equip
+	Chop
+	Mine
+	Terraform
 )
 
 func (tt TaskType) String() string {
@@ -32,6 +45,32 @@ func (tt TaskType) String() string {
 		return "Pick up"
 	case PlantSeed:
 		return "Plant seed"
+	case WarmUp:
+		return "Warm up"
+	case Chat:
+		return "Chat"
+	case Harvest:
+		return "Harvest"
+	case GatherSeeds:
+		return "Gather seeds"
+	case WaitForCrop:
+		return "Wait for crop"
+	case Drop:
+		return "Drop"
+	case Build:
+		return "Build"
+	case Repair:
+		return "Repair"
+	case Craft:
+		return "Craft"
+	case Equip:
+		return "Equip"
+	case Chop:
+		return "Chop"
+	case Mine:
+		return "Mine"
+	case Terraform:
+		return "Terraform"
 	default:
 		return "Unknown"
 	}
@@ -39,10 +78,16 @@ func (tt TaskType) String() string {
 
 func (sim *Sim) SetCurrentTask(character *Character) {
 	topObjective := sim.GetTopPriorityObjective(character)
+	// without a task there's no objective to stick to
+	character.ActiveObjective = NoObjective
 	if topObjective != nil {
-		nextTask := sim.CreateNextTask(character, topObjective)
+		nextTask := sim.ResumeTask(character, topObjective)
+		if nextTask == nil {
+			nextTask = sim.GetNextPlannedTask(character, topObjective)
+		}
 		if nextTask != nil {
 			character.CurrentTask = nextTask
+			character.ActiveObjective = topObjective.Type
 		} else {
 			topObjective.Stuck = true
 			fmt.Printf("Objective stuck because no task: %v\n", topObjective)
@@ -68,24 +113,58 @@ func (sim *Sim) WorkOnCurrentTask(character *Character) {
 		sim.PickUp(character)
 	case PlantSeed:
 		sim.PlantSeed(character)
+	case WarmUp:
+		sim.WarmUp(character)
+	case Chat:
+		sim.Chat(character)
+	case Harvest:
+		sim.Harvest(character)
+	case GatherSeeds:
+		sim.GatherSeeds(character)
+	case WaitForCrop:
+		sim.WaitForCrop(character)
+	case Drop:
+		sim.Drop(character)
+	case Build:
+		sim.Build(character)
+	case Repair:
+		sim.Repair(character)
+	case Craft:
+		sim.Craft(character)
+	case Equip:
+		sim.Equip(character)
+	case Chop:
+		sim.Chop(character)
+	case Mine:
+		sim.Mine(character)
+	case Terraform:
+		sim.Terraform(character)
 	}
+	sim.WearTool(character, task.Type)
+	character.TrainSkill(task.Type)
 	if task.Progress >= 100 {
 		sim.CompleteTask(character)
 	}
 }
 
-// Create next task for a given objective
-// Add it to the character's tasks if it's not nil
+// Create next task for a given objective, see BuildPlan which chains them into a plan
 func (sim *Sim) CreateNextTask(character *Character, objective *Objective) (task *Task) {
+	// eating and making food are planned from goals, see buildGoalPlan
 	switch objective.Type {
-	case EatObjective:
-		task = sim.GetNextEatingTask(character, objective)
 	case DrinkObjective:
 		task = sim.GetNextDrinkingTask(character, objective)
 	case SleepObjective:
 		task = sim.GetNextSleepingTask(character, objective)
-	case MakeFoodObjective:
-		task = sim.GetNextMakingFoodTask(character, objective)
+	case ShelterObjective:
+		task = sim.GetNextShelterTask(character, objective)
+	case WarmthObjective:
+		task = sim.GetNextWarmingTask(character, objective)
+	case WanderObjective:
+		task = sim.GetNextWanderingTask(character, objective)
+	case SocializeObjective:
+		task = sim.GetNextSocializingTask(character, objective)
+	case WorkObjective:
+		task = sim.GetNextWorkTask(character, objective)
 	}
 	return task
 }
@@ -94,8 +173,16 @@ func (sim *Sim) CompleteTask(character *Character) {
 	if character.CurrentTask == nil {
 		return
 	}
-	fmt.Printf("Completing task:  %v %v %v\n", character.Name, character.CurrentTask.Type, character.CurrentTask.Objective.Type)
-	sim.CheckIfObjectiveIsAchieved(character, character.CurrentTask.Objective)
+	// the objective may have been removed while the task was going on, see restoreTaskObjective
+	objective, objectiveType := character.CurrentTask.Objective, NoObjective
+	if objective != nil {
+		objectiveType = objective.Type
+	}
+	fmt.Printf("Completing task:  %v %v %v\n", character.Name, character.CurrentTask.Type, objectiveType)
+	sim.ReleaseTaskReservations(character.CurrentTask)
+	if objective != nil {
+		sim.CheckIfObjectiveIsAchieved(character, objective)
+	}
 	character.CurrentTask = nil
 }
 
@@ -104,5 +191,12 @@ func (sim *Sim) CancelTask(character *Character) {
 		return
 	}
 	fmt.Printf("Cancelling task:  %v %v %v\n", character.Name, character.CurrentTask.Type, character.CurrentTask.Objective)
+	sim.ReleaseTaskReservations(character.CurrentTask)
+	if character.CurrentTask.Objective != nil {
+		sim.ClearPlan(character, character.CurrentTask.Objective)
+		if character.CurrentTask.Objective.Type == WorkObjective {
+			sim.ReleaseJob(character)
+		}
+	}
 	character.CurrentTask = nil
 }
@@ -0,0 +1,68 @@
+package sim
+
+import (
+	"fmt"
+	"gociv/pkg/config"
+)
+
+// Build works on a construction site until it's finished
+func (sim *Sim) Build(character *Character) {
+	task := character.CurrentTask
+	structure := sim.GetStructureAt(*task.TargetTile)
+	if structure == nil || structure.BuildProgress >= 100 {
+		task.Progress = 100
+		return
+	}
+	// the materials are brought first, the task ends when the character has no more to give
+	if structure.MaterialsNeeded > 0 {
+		sim.DeliverMaterials(character, structure)
+		if structure.MaterialsNeeded > 0 {
+			task.Progress = 100
+			return
+		}
+	}
+	progress := config.BuildProgressPerTick * character.GetTaskSpeed(Build) * sim.GetEquippedToolSpeed(character, Build)
+	structure.BuildProgress = uint8(min(float32(structure.BuildProgress)+progress, 100))
+	task.Progress = float32(structure.BuildProgress)
+	fmt.Println("Building", character.Name, structure.StructureType, structure.BuildProgress)
+	if structure.BuildProgress >= 100 {
+		structure.Condition = 100
+	}
+}
+
+// DeliverMaterials uses the materials carried by the character for a construction site
+func (sim *Sim) DeliverMaterials(character *Character, structure *Structure) {
+	itemType, variant, _ := GetStructureCost(structure.StructureType)
+	for structure.MaterialsNeeded > 0 {
+		item := sim.FindInInventory(character, itemType, variant)
+		if item == nil {
+			return
+		}
+		sim.DecreaseItemStackCount(item.ID)
+		structure.MaterialsNeeded--
+	}
+	fmt.Printf("%v delivered the materials of %v\n", character.Name, structure.StructureType)
+}
+
+// Repair brings a worn structure back to full condition
+func (sim *Sim) Repair(character *Character) {
+	task := character.CurrentTask
+	structure := sim.GetStructureAt(*task.TargetTile)
+	if structure == nil || structure.Condition >= 100 {
+		task.Progress = 100
+		return
+	}
+	progress := config.RepairProgressPerTick * character.GetTaskSpeed(Repair) * sim.GetEquippedToolSpeed(character, Repair)
+	structure.Condition = uint8(min(float32(structure.Condition)+progress, 100))
+	task.Progress = float32(structure.Condition)
+	fmt.Println("Repairing", character.Name, structure.StructureType, structure.Condition)
+}
+
+// GetStructureAt returns the structure on a tile, nil if there is none
+func (sim *Sim) GetStructureAt(position TilePosition) *Structure {
+	tile := sim.GetTileAt(position)
+	if tile.Structure == -1 {
+		return nil
+	}
+	return sim.GetStructurePtrByID(tile.Structure)
+}
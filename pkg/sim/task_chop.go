@@ -0,0 +1,109 @@
+package sim
+
+import (
+	"fmt"
+	"gociv/pkg/config"
+	"gociv/pkg/data"
+)
+
+// Trees are chopped for wood, see data/plants.json for how much each one gives
+
+// IsChoppable returns true if the plant gives wood and is fully grown
+func IsChoppable(plant *Plant) bool {
+	if plant == nil || plant.GrowthStage < 100 {
+		return false
+	}
+	def, ok := data.GetPlantDefinition(int(plant.PlantType), plant.Variant)
+	return ok && def.Wood > 0
+}
+
+// GetChopYield returns the wood given by a plant, a young tree gives less, see config.YoungTreeWoodFactor
+func GetChopYield(plant *Plant) uint8 {
+	def, ok := data.GetPlantDefinition(int(plant.PlantType), plant.Variant)
+	if !ok {
+		return 0
+	}
+	if def.MaxAge == 0 {
+		return def.Wood
+	}
+	factor := min(config.YoungTreeWoodFactor+float32(plant.Age)/float32(def.MaxAge), 1)
+	return max(uint8(float32(def.Wood)*factor), 1)
+}
+
+// Chop fells the tree on the task's tile, its wood is left on the tile
+// trees which regrow leave a sapling behind
+func (sim *Sim) Chop(character *Character) {
+	task := character.CurrentTask
+	plant := sim.GetPlantAt(*task.TargetTile)
+	if !IsChoppable(plant) {
+		fmt.Printf("No tree to chop for %v at %v\n", character.Name, task.TargetTile)
+		sim.CancelTask(character)
+		return
+	}
+	task.Progress += config.ChopProgressPerTick * character.GetTaskSpeed(Chop) * sim.GetEquippedToolSpeed(character, Chop)
+	fmt.Println("Chopping", character.Name, task.TargetTile, task.Progress)
+	if task.Progress < 100 {
+		return
+	}
+	wood := GetChopYield(plant)
+	plantType, variant, position := plant.PlantType, plant.Variant, plant.Position
+	def, _ := data.GetPlantDefinition(int(plantType), variant)
+	sim.RemovePlant(plant.ID)
+	if def.Sapling {
+		sim.SpawnPlant(position, variant, plantType)
+	}
+	sim.AddItem(Item{Type: ItemTypeMaterial, Variant: MaterialWood, StackCount: wood}, ItemLocation{LocationType: LocTile, TilePosition: position})
+	fmt.Printf("%v chopped %v for %d wood\n", character.Name, def.Name, wood)
+}
+
+// DesignateChop marks the trees within a radius to be chopped, it returns how many were marked
+func (sim *Sim) DesignateChop(position TilePosition, radius int) int {
+	count := 0
+	if sim.PlantManager == nil {
+		return count
+	}
+	sim.PlantManager.ForEach(func(id int, p *Plant) {
+		if !p.ChopDesignated && IsChoppable(p) && GetTileDistance(position, p.Position) <= radius {
+			p.ChopDesignated = true
+			count++
+		}
+	})
+	return count
+}
+
+// DesignateTreesForConstruction marks the tree closest to a construction site when sites need more wood than there is
+func (sim *Sim) DesignateTreesForConstruction() {
+	if sim.PlantManager == nil {
+		return
+	}
+	needed := 0
+	var site *Structure
+	sim.StructureManager.ForEach(func(id int, s *Structure) {
+		if itemType, variant, _ := GetStructureCost(s.StructureType); s.BuildProgress < 100 && itemType == ItemTypeMaterial && variant == MaterialWood {
+			needed += int(s.MaterialsNeeded)
+			if site == nil && s.MaterialsNeeded > 0 {
+				site = s
+			}
+		}
+	})
+	needed -= sim.CountStoredItems(ItemTypeMaterial, MaterialWood)
+	var closest *Plant
+	closestDistance := -1
+	sim.PlantManager.ForEach(func(id int, p *Plant) {
+		if p.ChopDesignated {
+			needed -= int(GetChopYield(p))
+			return
+		}
+		if !IsChoppable(p) || site == nil {
+			return
+		}
+		if distance := GetTileDistance(site.Position, p.Position); closestDistance == -1 || distance < closestDistance {
+			closest = p
+			closestDistance = distance
+		}
+	})
+	if needed > 0 && closest != nil {
+		fmt.Printf("Tree %d at %v designated for construction wood\n", closest.ID, closest.Position)
+		closest.ChopDesignated = true
+	}
+}
@@ -11,10 +11,15 @@ func (sim *Sim) Drink(character *Character) {
 	if tile.Type != TileTypeWater && sim.FindStructureInTile(character.ID, *position, Well, -1, true) == nil {
 		return
 	}
+	if tile.Type != TileTypeWater && sim.AreWellsDry() {
+		fmt.Printf("Well is dry, %v can't drink\n", character.Name)
+		sim.CancelTask(character)
+		return
+	}
 	task.Progress += 50
 	fmt.Println("Drinking", character.Name)
 	if task.Progress >= 100 {
-		character.Needs.Water = 0
+		character.ChangeNeed(NeedWater, -character.GetNeed(NeedWater))
 		task.Progress = 100
 	}
 }
@@ -22,13 +27,7 @@ func (sim *Sim) Drink(character *Character) {
 func (sim *Sim) GetNextDrinkingTask(character *Character, objective *Objective) (task *Task) {
 	var newTask *Task
 	// Go to the closest water tile if needed, then drink
-	var closestWater *TilePosition
-	closestWell := sim.ScanForStructure(character.ID, character.TilePosition, -1, Well, -1, true)
-	if closestWell != nil {
-		closestWater = &closestWell.Position
-	} else {
-		closestWater = sim.ScanForTile(character.TilePosition, -1, TileTypeWater)
-	}
+	closestWater := sim.FindWaterSource(character, -1)
 	fmt.Printf("closestWater: %v\n", closestWater)
 	if closestWater == nil {
 		return
@@ -55,3 +54,15 @@ func (sim *Sim) GetNextDrinkingTask(character *Character, objective *Objective)
 	}
 	return newTask
 }
+
+// FindWaterSource returns the closest well to drink from, or natural water when there is none or wells are dry
+// maxDistance is -1 for no limit
+func (sim *Sim) FindWaterSource(character *Character, maxDistance int) *TilePosition {
+	// wells are dry during droughts, only natural water can be used
+	if !sim.AreWellsDry() {
+		if well := sim.ScanForStructure(character.ID, character.TilePosition, maxDistance, Well, -1, true); well != nil {
+			return &well.Position
+		}
+	}
+	return sim.ScanForTile(character.TilePosition, maxDistance, TileTypeWater)
+}
@@ -0,0 +1,126 @@
+package sim
+
+import (
+	"fmt"
+	"gociv/pkg/config"
+	"gociv/pkg/data"
+)
+
+// Harvest picks a mature crop, giving food and seeds to plant again
+func (sim *Sim) Harvest(character *Character) {
+	task := character.CurrentTask
+	field, index := sim.GetFieldTile(*task.TargetTile)
+	if field == nil || !field.TileStatus[index].IsMature() {
+		fmt.Printf("Nothing to harvest for %v at %v\n", character.Name, task.TargetTile)
+		sim.CancelTask(character)
+		return
+	}
+	task.Progress += 25 * character.GetTaskSpeed(Harvest) * sim.GetEquippedToolSpeed(character, Harvest)
+	fmt.Println("Harvesting", character.Name, task.TargetTile)
+	if task.Progress >= 100 {
+		// crops harvested as a job are left on the ground to be hauled to storage
+		forColony := task.Objective != nil && task.Objective.Type == WorkObjective
+		sim.HarvestFieldTile(character, field, index, !forColony)
+	}
+}
+
+// HarvestFieldTile gives the crop of a mature field tile to the character, or leaves it on the tile, and frees the tile
+func (sim *Sim) HarvestFieldTile(character *Character, field *Field, index int, toInventory bool) {
+	status := &field.TileStatus[index]
+	give := func(item Item) {
+		if toInventory {
+			sim.AddItemToInventory(character, item)
+		} else {
+			sim.AddItem(item, ItemLocation{LocationType: LocTile, TilePosition: field.Tiles[index]})
+		}
+	}
+	if foodItem, ok := data.GetItemDefinition(int(ItemTypeFood), status.SeedVariant); ok {
+		quality := status.Quality
+		if quality == 0 {
+			quality = 1
+		}
+		give(Item{
+			Type:       ItemTypeFood,
+			Variant:    foodItem.Variant,
+			Efficiency: uint8(min(float32(foodItem.Efficiency)*quality, 255)),
+		})
+	} else {
+		fmt.Printf("No food item for seed variant %v\n", status.SeedVariant)
+	}
+	give(Item{Type: ItemTypeSeed, Variant: status.SeedVariant, StackCount: config.HarvestSeedCount})
+	fmt.Printf("%v harvested %v\n", character.Name, field.Tiles[index])
+	*status = FieldTileStatus{}
+}
+
+// GatherSeeds takes seeds from a grown wild plant, which has to grow again before giving more
+func (sim *Sim) GatherSeeds(character *Character) {
+	task := character.CurrentTask
+	plant := sim.GetPlantAt(*task.TargetTile)
+	def := sim.GetPlantSeeds(plant)
+	if def == nil {
+		fmt.Printf("No seeds to gather for %v at %v\n", character.Name, task.TargetTile)
+		sim.CancelTask(character)
+		return
+	}
+	task.Progress += 25 * character.GetTaskSpeed(GatherSeeds) * sim.GetEquippedToolSpeed(character, GatherSeeds)
+	fmt.Println("Gathering seeds", character.Name, task.TargetTile)
+	if task.Progress >= 100 {
+		sim.AddItemToInventory(character, Item{Type: ItemTypeSeed, Variant: def.Variant, StackCount: def.Count})
+		plant.GrowthStage = 0
+	}
+}
+
+// WaitForCrop waits next to a growing crop until it can be harvested
+func (sim *Sim) WaitForCrop(character *Character) {
+	task := character.CurrentTask
+	field, index := sim.GetFieldTile(*task.TargetTile)
+	if field == nil || !field.TileStatus[index].Seeded {
+		fmt.Printf("No crop to wait for at %v\n", task.TargetTile)
+		sim.CancelTask(character)
+		return
+	}
+	task.Progress = float32(field.TileStatus[index].GrowthStage)
+	if field.TileStatus[index].IsMature() {
+		task.Progress = 100
+	}
+}
+
+// GetPlantAt returns the plant on a tile, nil if there is none
+func (sim *Sim) GetPlantAt(position TilePosition) *Plant {
+	tile := sim.GetTileAt(position)
+	if tile.Plant == -1 {
+		return nil
+	}
+	return sim.GetPlantByID(tile.Plant)
+}
+
+// GetPlantSeeds returns the seeds a plant gives, nil if it gives none or is not grown
+func (sim *Sim) GetPlantSeeds(plant *Plant) *data.SeedsDef {
+	if plant == nil || plant.GrowthStage < 100 {
+		return nil
+	}
+	def, ok := data.GetPlantDefinition(int(plant.PlantType), plant.Variant)
+	if !ok || def.Seeds.Count == 0 {
+		return nil
+	}
+	return &def.Seeds
+}
+
+// FindRipePlant returns the closest plant which gives seeds, nil if there is none
+func (sim *Sim) FindRipePlant(position TilePosition) *Plant {
+	if sim.PlantManager == nil {
+		return nil
+	}
+	var closest *Plant
+	closestDistance := -1
+	sim.PlantManager.ForEach(func(id int, p *Plant) {
+		if sim.GetPlantSeeds(p) == nil {
+			return
+		}
+		if distance := GetTileDistance(position, p.Position); closestDistance == -1 || distance < closestDistance {
+			closest = p
+			closestDistance = distance
+		}
+	})
+	return closest
+}
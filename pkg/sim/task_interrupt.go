@@ -0,0 +1,74 @@
+package sim
+
+import "fmt"
+
+// IsInterruptible returns false for short tasks which are always finished before switching objective
+func (tt TaskType) IsInterruptible() bool {
+	switch tt {
+	case Eat, Drink, PickUp, Drop:
+		return false
+	}
+	return true
+}
+
+// IsResumable returns true for tasks whose progress is kept when they're interrupted
+// other tasks are created again from scratch
+func (tt TaskType) IsResumable() bool {
+	return tt == PlantSeed || tt == Harvest || tt == GatherSeeds
+}
+
+// CheckTaskInterruption interrupts the current task if another objective now has a higher score
+// the current objective gets the hysteresis bonus so characters don't switch back and forth
+func (sim *Sim) CheckTaskInterruption(character *Character) {
+	task := character.CurrentTask
+	if task == nil || !task.Type.IsInterruptible() {
+		return
+	}
+	topObjective := sim.GetTopPriorityObjective(character)
+	if topObjective == nil || topObjective == task.Objective {
+		return
+	}
+	fmt.Printf("%v interrupts %v for %v\n", character.Name, task.Type, topObjective.Type)
+	sim.InterruptTask(character)
+	sim.SetCurrentTask(character)
+}
+
+// InterruptTask stops the current task, releasing its reservations and saving its progress on the objective if it can be resumed
+func (sim *Sim) InterruptTask(character *Character) {
+	task := character.CurrentTask
+	if task == nil {
+		return
+	}
+	sim.ReleaseTaskReservations(task)
+	if task.Objective != nil {
+		// what the plan was based on may change until the objective is picked again
+		sim.ClearPlan(character, task.Objective)
+		if task.Objective.Type == WorkObjective {
+			sim.ReleaseJob(character)
+		}
+	}
+	if task.Objective != nil && task.Type.IsResumable() && task.Progress > 0 {
+		suspended := *task
+		// the objective is set back when resuming, a task pointing to its own objective can't be saved
+		suspended.Objective = nil
+		task.Objective.Suspended = &suspended
+	}
+	character.CurrentTask = nil
+	character.Path = nil
+}
+
+// ResumeTask returns the objective's suspended task if it's still valid, nil otherwise
+func (sim *Sim) ResumeTask(character *Character, objective *Objective) *Task {
+	task := objective.Suspended
+	if task == nil {
+		return nil
+	}
+	objective.Suspended = nil
+	if !sim.IsTaskValid(character, task) {
+		fmt.Printf("%v can't resume %v\n", character.Name, task.Type)
+		return nil
+	}
+	fmt.Printf("%v resumes %v at %.0f%%\n", character.Name, task.Type, task.Progress)
+	task.Objective = objective
+	return task
+}
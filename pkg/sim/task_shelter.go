@@ -0,0 +1,20 @@
+package sim
+
+import "fmt"
+
+// Set next task required to get out of bad weather
+func (sim *Sim) GetNextShelterTask(character *Character, objective *Objective) (task *Task) {
+	closestShelter := sim.ScanForTileMatching(character.TilePosition, -1, func(tile *Tile) bool {
+		return tile.IsSheltered()
+	})
+	if closestShelter == nil {
+		ObjectiveFailed(character, objective)
+		fmt.Printf("No shelter found for %v\n", character.Name)
+		return nil
+	}
+	return &Task{
+		Objective:  objective,
+		Type:       Move,
+		TargetTile: closestShelter,
+	}
+}
@@ -14,7 +14,7 @@ func (sim *Sim) GetNextSleepingTask(character *Character, objective *Objective)
 			Type:      Sleep,
 		}
 	} else {
-		// Else, go to their bed if they have one or claim one if they don't have one
+		// Else, go to their bed if they have one or reserve one if they don't have one
 		characterBeds := sim.StructureManager.GetStructuresByOwnerAndType(character.ID, Bed)
 		if len(characterBeds) > 0 {
 			newTask = &Task{
@@ -23,18 +23,29 @@ func (sim *Sim) GetNextSleepingTask(character *Character, objective *Objective)
 				TargetTile: &characterBeds[0].Position,
 			}
 		} else {
-			// Claim the closest bed
+			// Share the bed of a partner or close friend, else reserve the closest bed, it's owned once slept in
 			closestBed := sim.ScanForStructure(character.ID, character.TilePosition, config.RegionSize, Bed, -1, true)
-			if closestBed != nil {
-				closestBed.Owner = character.ID
+			if sharedBed := sim.GetSharedBed(character); sharedBed != nil {
+				newTask = &Task{
+					Objective:  objective,
+					Type:       Move,
+					TargetTile: &sharedBed.Position,
+				}
+			} else if closestBed != nil {
 				newTask = &Task{
 					Objective:  objective,
 					Type:       Move,
 					TargetTile: &closestBed.Position,
 				}
+				sim.ReserveStructure(character, newTask, closestBed)
 			} else {
-				// If no bed found, add an objective to build one
-				fmt.Printf("No bed found for %v, adding objective to build one\n", character.Name)
+				// If no bed found, sleep on the ground
+				// TODO: add an objective to build one
+				fmt.Printf("No bed found for %v, sleeping on the ground\n", character.Name)
+				newTask = &Task{
+					Objective: objective,
+					Type:      Sleep,
+				}
 			}
 		}
 	}
@@ -44,9 +55,21 @@ func (sim *Sim) GetNextSleepingTask(character *Character, objective *Objective)
 func (sim *Sim) Sleep(character *Character) {
 	task := character.CurrentTask
 	fmt.Println("Sleeping", character.Name)
-	character.Needs.Sleep -= 5
-	if character.Needs.Sleep <= 0 {
-		character.Needs.Sleep = 0
+	inBed := sim.IsRestingInBed(character)
+	if bed := sim.FindStructureInTile(character.ID, character.TilePosition, Bed, -1, false); bed != nil && bed.Owner == -1 {
+		bed.Owner = character.ID
+	}
+	if inBed {
+		character.ChangeNeed(NeedSleep, -5)
+	} else {
+		character.ChangeNeed(NeedSleep, -config.GroundSleepRecovery)
+	}
+	// during the night keep sleeping until morning, unless woken up by another need
+	keepSleeping := character.GetScheduleBlock(sim.Calendar.Hour) == ScheduleSleep && !character.HasCriticalNeed()
+	if character.GetNeed(NeedSleep) <= 0 && !keepSleeping {
 		task.Progress = 100
+		if !inBed {
+			sim.AddThought(character, ThoughtSleptOnGround)
+		}
 	}
 }
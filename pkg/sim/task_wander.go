@@ -0,0 +1,26 @@
+package sim
+
+import "gociv/pkg/config"
+
+// Set next task required to wander: walk to a random nearby tile
+func (sim *Sim) GetNextWanderingTask(character *Character, objective *Objective) (task *Task) {
+	for attempt := 0; attempt < 10; attempt++ {
+		target := TilePosition{
+			X: character.TilePosition.X + int16(sim.RNG.IntRange(-config.WanderRadius, config.WanderRadius)),
+			Y: character.TilePosition.Y + int16(sim.RNG.IntRange(-config.WanderRadius, config.WanderRadius)),
+		}
+		if target.X < 0 || target.X >= config.RegionSize || target.Y < 0 || target.Y >= config.RegionSize {
+			continue
+		}
+		if sim.GetTileAt(target).MoveCost == ImpassableCost {
+			continue
+		}
+		return &Task{
+			Objective:  objective,
+			Type:       Move,
+			TargetTile: &target,
+		}
+	}
+	ObjectiveFailed(character, objective)
+	return nil
+}
@@ -0,0 +1,50 @@
+package sim
+
+import (
+	"fmt"
+)
+
+// Set next task required to get warm: go next to a fire or in a room and wait there
+func (sim *Sim) GetNextWarmingTask(character *Character, objective *Objective) (task *Task) {
+	if sim.IsWarmTile(character.TilePosition) {
+		return &Task{
+			Objective:  objective,
+			Type:       WarmUp,
+			TargetTile: &character.TilePosition,
+		}
+	}
+	fireplaces := sim.GetFireplaces()
+	warmSpot := sim.ScanForTileMatching(character.TilePosition, -1, func(tile *Tile) bool {
+		return tile.MoveCost != ImpassableCost && sim.isWarmTile(tile.Position, fireplaces)
+	})
+	if warmSpot == nil {
+		// no warm spot, at least get out of the weather
+		warmSpot = sim.ScanForTileMatching(character.TilePosition, -1, func(tile *Tile) bool {
+			return tile.IsSheltered()
+		})
+	}
+	if warmSpot == nil || warmSpot.IsSameAs(character.TilePosition) {
+		ObjectiveFailed(character, objective)
+		fmt.Printf("No warm place found for %v\n", character.Name)
+		return nil
+	}
+	return &Task{
+		Objective:  objective,
+		Type:       Move,
+		TargetTile: warmSpot,
+	}
+}
+
+func (sim *Sim) WarmUp(character *Character) {
+	task := character.CurrentTask
+	if !sim.IsWarmTile(character.TilePosition) {
+		fmt.Printf("%v is not in a warm place anymore\n", character.Name)
+		sim.CancelTask(character)
+		return
+	}
+	fmt.Println("Warming up", character.Name)
+	// warmth itself decreases in UpdateWarmth, the task just waits for it
+	if character.GetNeed(NeedWarmth) <= 0 {
+		task.Progress = 100
+	}
+}
@@ -0,0 +1,88 @@
+package sim
+
+import (
+	"gociv/pkg/config"
+	"math"
+)
+
+// Average outdoor temperature per season, in Celsius
+var seasonBaseTemperature = map[Season]float32{
+	Spring: 12,
+	Summer: 24,
+	Autumn: 10,
+	Winter: -2,
+}
+
+var weatherTemperatureModifier = map[WeatherType]float32{
+	Clear:   0,
+	Rain:    -2,
+	Storm:   -4,
+	Drought: 4,
+	Snow:    -6,
+}
+
+// GetAmbientTemperature returns the outdoor temperature based on season, time of day and weather
+func (sim *Sim) GetAmbientTemperature() float32 {
+	temperature := seasonBaseTemperature[sim.Calendar.Season()]
+	// daily swing, warmest at 14:00 and coldest at 2:00
+	hour := float64(sim.Calendar.Hour) + float64(sim.Calendar.Minute)/60
+	temperature += float32(math.Cos((hour-14)/24*2*math.Pi)) * config.DailyTemperatureSwing
+	temperature += weatherTemperatureModifier[sim.Weather.Type]
+	return temperature
+}
+
+// GetTileTemperature returns the temperature felt on a tile
+// rooms are insulated (closer to comfort temperature) and fireplaces heat their surroundings
+func (sim *Sim) GetTileTemperature(position TilePosition) float32 {
+	return sim.getTileTemperature(position, sim.GetFireplaces())
+}
+
+// getTileTemperature is GetTileTemperature with the built fireplaces collected by the caller, e.g. once per search
+func (sim *Sim) getTileTemperature(position TilePosition, fireplaces []TilePosition) float32 {
+	temperature := sim.GetAmbientTemperature()
+	tile := sim.GetTileAt(position)
+	if tile.ZoneType == ZoneTypeRoom {
+		temperature += (config.ComfortTemperature - temperature) * config.RoomInsulation
+	}
+	for _, fireplace := range fireplaces {
+		distance := math.Max(math.Abs(float64(fireplace.X-position.X)), math.Abs(float64(fireplace.Y-position.Y)))
+		if distance <= config.FireplaceRadius {
+			temperature += config.FireplaceHeat * float32(1-distance/(config.FireplaceRadius+1))
+		}
+	}
+	return temperature
+}
+
+// GetFireplaces returns the positions of the built fireplaces
+func (sim *Sim) GetFireplaces() []TilePosition {
+	var fireplaces []TilePosition
+	if sim.StructureManager != nil {
+		sim.StructureManager.ForEach(func(id int, s *Structure) {
+			if s.StructureType == Fireplace && s.BuildProgress >= 100 {
+				fireplaces = append(fireplaces, s.Position)
+			}
+		})
+	}
+	return fireplaces
+}
+
+// IsWarmTile returns true if a character can warm up on the tile
+func (sim *Sim) IsWarmTile(position TilePosition) bool {
+	return sim.isWarmTile(position, sim.GetFireplaces())
+}
+
+func (sim *Sim) isWarmTile(position TilePosition, fireplaces []TilePosition) bool {
+	return sim.getTileTemperature(position, fireplaces) >= config.ComfortTemperature
+}
+
+// UpdateWarmth makes the character colder or warmer depending on the temperature of its tile
+func (sim *Sim) UpdateWarmth(character *Character) {
+	temperature := sim.GetTileTemperature(character.TilePosition)
+	if temperature < config.ColdTemperature {
+		character.ChangeNeed(NeedWarmth, float32(1+int((config.ColdTemperature-temperature)/5)))
+	} else if temperature >= config.ComfortTemperature {
+		character.ChangeNeed(NeedWarmth, -5)
+	} else {
+		character.ChangeNeed(NeedWarmth, -1)
+	}
+}
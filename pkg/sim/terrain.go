@@ -0,0 +1,238 @@
+package sim
+
+import (
+	"fmt"
+	"gociv/pkg/config"
+	"strings"
+)
+
+// Tiles are designated by the player to be changed by characters, see JobMine and JobTerrain
+type TerrainWork uint8
+
+const (
+	TerrainNone  TerrainWork = iota
+	TerrainMine              // wall into floor, gives stone
+	TerrainFloor             // empty ground or dirt into floor, uses stone
+	TerrainFill              // water into dirt, uses stone
+	TerrainTill              // empty ground or floor into dirt, for fields
+)
+
+func (tw TerrainWork) String() string {
+	switch tw {
+	case TerrainNone:
+		return "None"
+	case TerrainMine:
+		return "Mine"
+	case TerrainFloor:
+		return "Floor"
+	case TerrainFill:
+		return "Fill"
+	case TerrainTill:
+		return "Till"
+	default:
+		return "Unknown"
+	}
+}
+
+// ParseTerrainWork returns the terrain work with the given name, case insensitive
+func ParseTerrainWork(name string) (TerrainWork, bool) {
+	for tw := TerrainNone; tw <= TerrainTill; tw++ {
+		if strings.EqualFold(tw.String(), name) {
+			return tw, true
+		}
+	}
+	return TerrainNone, false
+}
+
+// GetTerrainResult returns the tile type a terrain work turns a tile into
+func (tw TerrainWork) GetTerrainResult() TileType {
+	switch tw {
+	case TerrainMine, TerrainFloor:
+		return TileTypeFloor
+	default:
+		return TileTypeDirt
+	}
+}
+
+// GetTerrainCost returns the stone used by a terrain work
+func (tw TerrainWork) GetTerrainCost() uint8 {
+	switch tw {
+	case TerrainFloor:
+		return config.FloorStoneCost
+	case TerrainFill:
+		return config.FillStoneCost
+	}
+	return 0
+}
+
+// CanDoTerrainWork returns true if the terrain work makes sense on the tile
+func (sim *Sim) CanDoTerrainWork(tile *Tile, work TerrainWork) bool {
+	if tile.Structure != -1 {
+		return false
+	}
+	switch work {
+	case TerrainMine:
+		return tile.Type == TileTypeWall
+	case TerrainFloor:
+		return tile.Type == TileTypeEmpty || tile.Type == TileTypeDirt
+	case TerrainFill:
+		return tile.Type == TileTypeWater
+	case TerrainTill:
+		return tile.Type == TileTypeEmpty || tile.Type == TileTypeFloor
+	}
+	return false
+}
+
+// DesignateTerrain marks the tiles within a radius for a terrain work, TerrainNone cancels
+// tiles where the work makes no sense are skipped, it returns how many tiles were changed
+func (sim *Sim) DesignateTerrain(position TilePosition, radius int, work TerrainWork) int {
+	count := 0
+	for x := position.X - int16(radius); x <= position.X+int16(radius); x++ {
+		for y := position.Y - int16(radius); y <= position.Y+int16(radius); y++ {
+			if x < 0 || x >= config.RegionSize || y < 0 || y >= config.RegionSize {
+				continue
+			}
+			tile := sim.GetTileAt(TilePosition{X: x, Y: y})
+			if work != TerrainNone && !sim.CanDoTerrainWork(tile, work) {
+				continue
+			}
+			if tile.Designation != work {
+				tile.Designation = work
+				tile.Progress = 0
+				count++
+			}
+		}
+	}
+	return count
+}
+
+// ChangeTileType changes the terrain of a tile and updates what depends on it: move costs and rooms
+func (sim *Sim) ChangeTileType(tile *Tile, tileType TileType) {
+	tile.UpdateType(tileType)
+	sim.ApplyWeatherMoveCost(tile)
+	sim.UpdateRooms()
+}
+
+// PostTerrainJobs posts a job for each designated tile, the ones using stone only when there is some
+func (sim *Sim) PostTerrainJobs() {
+	hasStone := sim.CountStoredItems(ItemTypeMaterial, MaterialStone) > 0
+	for i := range sim.Tiles {
+		tile := &sim.Tiles[i]
+		switch {
+		case tile.Designation == TerrainNone:
+		case tile.Designation == TerrainMine:
+			sim.PostJob(Job{Type: JobMine, Position: tile.Position})
+		case tile.Designation.GetTerrainCost() == 0 || hasStone:
+			sim.PostJob(Job{Type: JobTerrain, Position: tile.Position})
+		}
+	}
+}
+
+// IsTerrainJobNeeded returns true while the job's tile is designated and the work can be done
+func (sim *Sim) IsTerrainJobNeeded(job *Job) bool {
+	tile := sim.GetTileAt(job.Position)
+	if tile.Designation == TerrainNone || (tile.Designation == TerrainMine) != (job.Type == JobMine) {
+		return false
+	}
+	return sim.CanDoTerrainWork(tile, tile.Designation)
+}
+
+// GetNextTerrainTask brings the stone needed by the job's tile, then works next to it
+func (sim *Sim) GetNextTerrainTask(character *Character, objective *Objective, job *Job) *Task {
+	tile := sim.GetTileAt(job.Position)
+	if cost := tile.Designation.GetTerrainCost(); cost > 0 {
+		carried := 0
+		for _, item := range sim.GetInventoryItems(character, ItemTypeMaterial, MaterialStone) {
+			carried += int(item.StackCount)
+		}
+		if carried < int(cost) {
+			item := sim.ScanForItem(character.ID, character.TilePosition, -1, ItemTypeMaterial, MaterialStone, true)
+			if item == nil {
+				ObjectiveFailed(character, objective)
+				return nil
+			}
+			if !item.Location.TilePosition.IsSameAs(character.TilePosition) {
+				return &Task{Objective: objective, Type: Move, TargetTile: &item.Location.TilePosition}
+			}
+			task := &Task{Objective: objective, Type: PickUp, TargetItem: item, Count: cost - uint8(carried)}
+			sim.ReserveItem(character, task, item)
+			return task
+		}
+	}
+	taskType := Terraform
+	if job.Type == JobMine {
+		taskType = Mine
+	}
+	// work next to the tile, walls can't be walked on and water is better avoided
+	if IsAdjacent(character.TilePosition.X, character.TilePosition.Y, job.Position.X, job.Position.Y) && !character.TilePosition.IsSameAs(job.Position) {
+		task := &Task{Objective: objective, Type: taskType, TargetTile: &job.Position}
+		sim.ReserveTile(character, task, job.Position)
+		return task
+	}
+	path := sim.FindPath(character.TilePosition, job.Position, 1)
+	if len(path) == 0 {
+		ObjectiveFailed(character, objective)
+		return nil
+	}
+	return &Task{Objective: objective, Type: Move, TargetTile: &path[len(path)-1]}
+}
+
+// Mine digs the wall of the task's tile into floor, its stone is left on the tile
+func (sim *Sim) Mine(character *Character) {
+	task := character.CurrentTask
+	tile := sim.GetTileAt(*task.TargetTile)
+	if tile.Designation != TerrainMine || !sim.CanDoTerrainWork(tile, TerrainMine) {
+		fmt.Printf("No wall to mine for %v at %v\n", character.Name, task.TargetTile)
+		sim.CancelTask(character)
+		return
+	}
+	tile.Progress = min(tile.Progress+config.MineProgressPerTick*character.GetTaskSpeed(Mine)*sim.GetEquippedToolSpeed(character, Mine), 100)
+	task.Progress = tile.Progress
+	fmt.Println("Mining", character.Name, task.TargetTile, task.Progress)
+	if task.Progress < 100 {
+		return
+	}
+	tile.Designation = TerrainNone
+	tile.Progress = 0
+	sim.ChangeTileType(tile, TerrainMine.GetTerrainResult())
+	sim.AddItem(Item{Type: ItemTypeMaterial, Variant: MaterialStone, StackCount: config.MineStoneYield}, ItemLocation{LocationType: LocTile, TilePosition: tile.Position})
+	fmt.Printf("%v mined %v\n", character.Name, tile.Position)
+}
+
+// Terraform builds a floor, fills water or tills the ground of the task's tile, using the stone carried
+func (sim *Sim) Terraform(character *Character) {
+	task := character.CurrentTask
+	tile := sim.GetTileAt(*task.TargetTile)
+	work := tile.Designation
+	if work == TerrainNone || work == TerrainMine || !sim.CanDoTerrainWork(tile, work) {
+		fmt.Printf("No terrain work for %v at %v\n", character.Name, task.TargetTile)
+		sim.CancelTask(character)
+		return
+	}
+	tile.Progress = min(tile.Progress+config.TerraformProgressPerTick*character.GetTaskSpeed(Terraform)*sim.GetEquippedToolSpeed(character, Terraform), 100)
+	task.Progress = tile.Progress
+	fmt.Println("Terraforming", character.Name, work, task.TargetTile, task.Progress)
+	if task.Progress < 100 {
+		return
+	}
+	carried := 0
+	for _, item := range sim.GetInventoryItems(character, ItemTypeMaterial, MaterialStone) {
+		carried += int(item.StackCount)
+	}
+	if carried < int(work.GetTerrainCost()) {
+		fmt.Printf("WARNING: %v has no stone for %v\n", character.Name, work)
+		sim.CancelTask(character)
+		return
+	}
+	for range work.GetTerrainCost() {
+		sim.DecreaseItemStackCount(sim.FindInInventory(character, ItemTypeMaterial, MaterialStone).ID)
+	}
+	// a floor or tilled ground replaces what grew there
+	if tile.Plant != -1 {
+		sim.RemovePlant(tile.Plant)
+	}
+	tile.Designation = TerrainNone
+	tile.Progress = 0
+	sim.ChangeTileType(tile, work.GetTerrainResult())
+	fmt.Printf("%v did %v at %v\n", character.Name, work, tile.Position)
+}
@@ -52,6 +52,16 @@ func (t *Tile) UpdateType(newType TileType) {
 	}
 }
 
+// IsSheltered returns true if the tile protects from the weather, i.e. it's in an enclosed room
+func (t *Tile) IsSheltered() bool {
+	return t.ZoneType == ZoneTypeRoom
+}
+
+// IsFreeForField returns true for dirt tiles which aren't part of a field or room yet
+func (t *Tile) IsFreeForField() bool {
+	return t.Type == TileTypeDirt && t.ZoneType == ZoneTypeNone
+}
+
 func (t *Tile) AddItem(itemID int32) {
 	t.Items = append(t.Items, itemID)
 }
@@ -69,6 +79,11 @@ func IsAdjacent(x1, y1, x2, y2 int16) bool {
 	return math.Abs(float64(x1-x2)) <= 1 && math.Abs(float64(y1-y2)) <= 1
 }
 
+// GetTileDistance returns the number of steps between two tiles, moving diagonally counts as one step
+func GetTileDistance(a, b TilePosition) int {
+	return int(math.Max(math.Abs(float64(a.X-b.X)), math.Abs(float64(a.Y-b.Y))))
+}
+
 func (t *TilePosition) IsSameAs(otherTile TilePosition) bool {
 	return t.X == otherTile.X && t.Y == otherTile.Y
 }
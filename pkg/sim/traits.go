@@ -0,0 +1,74 @@
+package sim
+
+import (
+	"fmt"
+	"gociv/pkg/data"
+)
+
+// Traits are defined in data/traits.json
+type TraitType int
+
+func (tt TraitType) String() string {
+	if def, ok := data.GetTraitDefinition(int(tt)); ok {
+		return def.Name
+	}
+	return fmt.Sprintf("Trait %d", int(tt))
+}
+
+func (character *Character) HasTrait(traitType TraitType) bool {
+	for _, trait := range character.Traits {
+		if trait == traitType {
+			return true
+		}
+	}
+	return false
+}
+
+// CanHaveTrait returns false if the character already has the trait or one it excludes
+func (character *Character) CanHaveTrait(traitType TraitType) bool {
+	if character.HasTrait(traitType) {
+		return false
+	}
+	def, ok := data.GetTraitDefinition(int(traitType))
+	if !ok {
+		return false
+	}
+	for _, excluded := range def.Excludes {
+		if character.HasTrait(TraitType(excluded)) {
+			return false
+		}
+	}
+	return true
+}
+
+// AddTrait gives a trait to a character and applies its need rates and schedule shift
+func (character *Character) AddTrait(traitType TraitType) {
+	def, ok := data.GetTraitDefinition(int(traitType))
+	if !ok {
+		fmt.Printf("Unknown trait %d\n", traitType)
+		return
+	}
+	character.Traits = append(character.Traits, traitType)
+	if character.NeedModifiers == nil {
+		character.NeedModifiers = map[NeedType]float32{}
+	}
+	for needType, rate := range def.NeedRates {
+		character.NeedModifiers[NeedType(needType)] = character.GetNeedModifier(NeedType(needType)) * rate
+	}
+	if def.ScheduleShift != 0 {
+		character.Schedule = character.Schedule.Shift(def.ScheduleShift)
+	}
+}
+
+// GetSkillGainModifier returns the multiplier of the experience gained in a skill
+func (character *Character) GetSkillGainModifier(skillType SkillType) float32 {
+	modifier := float32(1)
+	for _, trait := range character.Traits {
+		if def, ok := data.GetTraitDefinition(int(trait)); ok {
+			if gain, ok := def.SkillGains[int(skillType)]; ok {
+				modifier *= gain
+			}
+		}
+	}
+	return modifier
+}
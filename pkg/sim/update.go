@@ -1,6 +1,9 @@
 package sim
 
-import "fmt"
+import (
+	"fmt"
+	"gociv/pkg/config"
+)
 
 const SIM_STEP = 1.0 // seconds per simulation tick
 
@@ -8,9 +11,18 @@ const SIM_STEP = 1.0 // seconds per simulation tick
 func (s *Sim) LogicUpdate() {
 	fmt.Println("TICK !")
 	s.UpdateTime()
+	s.UpdateWeather()
 	s.UpdateCharacters()
+	s.UpdateReservations()
 	s.UpdatePlants()
 	s.UpdateFields()
+	s.UpdateStructures()
+	if s.Time%config.ItemDecayInterval == 0 {
+		s.UpdateItemDecay()
+	}
+	if s.Time%config.JobUpdateInterval == 0 {
+		s.UpdateJobs()
+	}
 }
 
 // Things needed to be done every frame (movement...)
@@ -31,4 +43,8 @@ func (sim *Sim) UpdateTime() {
 		sim.Calendar.Hour = 0
 		sim.Calendar.Day++
 	}
+	if sim.Calendar.Day >= config.DaysPerYear {
+		sim.Calendar.Day = 0
+		sim.Calendar.Year++
+	}
 }
@@ -0,0 +1,109 @@
+package sim
+
+import (
+	"gociv/pkg/config"
+	"gociv/pkg/data"
+)
+
+// ScoreObjective returns how useful it is for the character to pursue an objective right now, higher is better
+// 0 means the objective should not be pursued
+func (sim *Sim) ScoreObjective(character *Character, objective *Objective) float32 {
+	if (character.HasLowMood() || character.LifeStage == Child) && objective.Type.IsWork() {
+		return 0
+	}
+
+	score := sim.GetObjectiveUrgency(character, objective)
+
+	// far away resources make an objective less attractive
+	if distance := sim.GetObjectiveDistance(character, objective); distance >= 0 {
+		score -= min(float32(distance)*config.UtilityDistancePenalty, config.UtilityMaxDistancePenalty)
+	}
+
+	score *= GetScheduleWeight(character.GetScheduleBlock(sim.Calendar.Hour), objective.Type)
+	score *= character.GetObjectiveTraitWeight(objective.Type)
+
+	// hysteresis: stick to the current objective unless another one is clearly better
+	if objective.Type == character.ActiveObjective {
+		score *= config.UtilityHysteresis
+	}
+
+	return max(score, 0)
+}
+
+// GetObjectiveUrgency returns the base score of an objective, before distance, schedule and traits
+func (sim *Sim) GetObjectiveUrgency(character *Character, objective *Objective) float32 {
+	// objectives triggered by a need are as urgent as the need: 100 when critical, more when health is at risk
+	if needType, ok := GetObjectiveNeed(objective.Type); ok {
+		def, _ := data.GetNeedDefinition(int(needType))
+		if def == nil || def.Critical == 0 {
+			return 0
+		}
+		ratio := character.GetNeed(needType) / def.Critical
+		urgency := 100 * ratio * ratio
+		// a rested character still stays in bed at night, critical needs wake them up
+		if objective.Type == SleepObjective && character.GetScheduleBlock(sim.Calendar.Hour) == ScheduleSleep {
+			urgency = max(urgency, config.NightSleepUtility)
+		}
+		return urgency
+	}
+
+	switch objective.Type {
+	case ShelterObjective:
+		return config.ShelterUtility
+	case MakeFoodObjective:
+		// more urgent as the character gets hungry
+		return config.MakeFoodUtility + character.GetNeed(NeedFood)/config.NeedCap*config.MakeFoodUtility
+	case BuildObjective:
+		return config.BuildUtility
+	case WorkObjective:
+		return config.WorkUtility
+	case WanderObjective:
+		return config.WanderUtility + max(config.MoodBreakThreshold-character.Mood, 0)*2
+	}
+	return 0
+}
+
+// GetObjectiveDistance returns the distance to the resource needed by an objective, -1 if not relevant
+// it's kept on the objective for a while since objectives are scored every tick
+func (sim *Sim) GetObjectiveDistance(character *Character, objective *Objective) int {
+	if sim.Time < objective.DistanceExpiresAt {
+		return objective.Distance
+	}
+	objective.Distance = sim.scanObjectiveDistance(character, objective)
+	objective.DistanceExpiresAt = sim.Time + config.UtilityDistanceCacheTime
+	return objective.Distance
+}
+
+func (sim *Sim) scanObjectiveDistance(character *Character, objective *Objective) int {
+	var target *TilePosition
+	switch objective.Type {
+	case DrinkObjective:
+		target = sim.FindWaterSource(character, config.UtilityScanDistance)
+	case EatObjective:
+		if sim.FindInInventory(character, ItemTypeFood, -1) != nil {
+			return 0
+		}
+		if food := sim.ScanForFood(character.ID, character.TilePosition, config.UtilityScanDistance); food != nil {
+			target = &food.Location.TilePosition
+		}
+	default:
+		return -1
+	}
+	if target == nil {
+		return config.UtilityScanDistance
+	}
+	return GetTileDistance(character.TilePosition, *target)
+}
+
+// GetObjectiveTraitWeight returns how much the character's traits favor an objective, e.g. a green thumb likes farming
+func (character *Character) GetObjectiveTraitWeight(objectiveType ObjectiveType) float32 {
+	weight := float32(1)
+	for _, trait := range character.Traits {
+		if def, ok := data.GetTraitDefinition(int(trait)); ok {
+			if w, ok := def.ObjectiveWeights[int(objectiveType)]; ok {
+				weight *= w
+			}
+		}
+	}
+	return weight
+}
@@ -0,0 +1,141 @@
+package sim
+
+import (
+	"fmt"
+	"gociv/pkg/config"
+	"strings"
+)
+
+type WeatherType int
+
+const (
+	Clear WeatherType = iota
+	Rain
+	Storm
+	Drought
+	Snow
+)
+
+func (wt WeatherType) String() string {
+	switch wt {
+	case Clear:
+		return "Clear"
+	case Rain:
+		return "Rain"
+	case Storm:
+		return "Storm"
+	case Drought:
+		return "Drought"
+	case Snow:
+		return "Snow"
+	default:
+		return "Unknown"
+	}
+}
+
+// ParseWeatherType returns the weather type matching a name (case insensitive)
+func ParseWeatherType(name string) (WeatherType, bool) {
+	for wt := Clear; wt <= Snow; wt++ {
+		if strings.EqualFold(wt.String(), name) {
+			return wt, true
+		}
+	}
+	return Clear, false
+}
+
+type weatherWeight struct {
+	Type   WeatherType
+	Weight int
+}
+
+// Relative odds of each weather type per season
+// slices rather than maps so that rolls are deterministic for a given RNG state
+var seasonWeatherWeights = map[Season][]weatherWeight{
+	Spring: {{Clear, 5}, {Rain, 4}, {Storm, 1}},
+	Summer: {{Clear, 6}, {Rain, 1}, {Storm, 1}, {Drought, 2}},
+	Autumn: {{Clear, 4}, {Rain, 4}, {Storm, 2}},
+	Winter: {{Clear, 4}, {Snow, 5}, {Storm, 1}},
+}
+
+func (sim *Sim) UpdateWeather() {
+	if sim.Weather.RemainingTicks > 0 {
+		sim.Weather.RemainingTicks--
+	}
+	if sim.Weather.RemainingTicks <= 0 {
+		duration := sim.RNG.IntRange(config.WeatherMinDuration, config.WeatherMaxDuration)
+		sim.SetWeather(sim.RollWeather(), duration, false)
+	}
+	sim.ApplyWeatherEffects()
+}
+
+// RollWeather picks a weather type for the current season using the sim RNG
+func (sim *Sim) RollWeather() WeatherType {
+	weights := seasonWeatherWeights[sim.Calendar.Season()]
+	total := 0
+	for _, w := range weights {
+		total += w.Weight
+	}
+	roll := sim.RNG.Intn(total)
+	for _, w := range weights {
+		if roll < w.Weight {
+			return w.Type
+		}
+		roll -= w.Weight
+	}
+	return Clear
+}
+
+// SetWeather changes the current weather for a number of ticks
+// forced is used by the console for testing
+func (sim *Sim) SetWeather(weatherType WeatherType, duration int, forced bool) {
+	previous := sim.Weather.Type
+	sim.Weather = Weather{
+		Type:           weatherType,
+		RemainingTicks: duration,
+		Forced:         forced,
+	}
+	fmt.Printf("Weather is now %v for %d ticks\n", weatherType, duration)
+	if previous != weatherType && (previous == Snow || weatherType == Snow) {
+		sim.UpdateMoveCosts()
+	}
+}
+
+// ApplyWeatherEffects applies the per tick effects of the current weather
+func (sim *Sim) ApplyWeatherEffects() {
+	switch sim.Weather.Type {
+	case Rain, Storm:
+		// rain waters all fields automatically
+		for i := range sim.Fields {
+			for j := range sim.Fields[i].TileStatus {
+				sim.Fields[i].TileStatus[j].Watered = true
+			}
+		}
+	case Drought:
+		for i := range sim.Fields {
+			for j := range sim.Fields[i].TileStatus {
+				sim.Fields[i].TileStatus[j].Watered = false
+			}
+		}
+	}
+}
+
+// AreWellsDry returns true when wells can't be used to drink
+func (sim *Sim) AreWellsDry() bool {
+	return sim.Weather.Type == Drought
+}
+
+// UpdateMoveCosts recomputes the move cost of all tiles, e.g. when snow starts or melts
+func (sim *Sim) UpdateMoveCosts() {
+	for i := range sim.Tiles {
+		sim.Tiles[i].UpdateType(sim.Tiles[i].Type)
+		sim.ApplyWeatherMoveCost(&sim.Tiles[i])
+	}
+}
+
+// ApplyWeatherMoveCost raises the move cost of outdoor tiles covered in snow
+func (sim *Sim) ApplyWeatherMoveCost(tile *Tile) {
+	if sim.Weather.Type != Snow || tile.MoveCost == ImpassableCost || tile.IsSheltered() {
+		return
+	}
+	tile.MoveCost = DifficultMoveCost
+}
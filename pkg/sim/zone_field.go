@@ -1,9 +1,7 @@
 package sim
 
 import (
-	"fmt"
 	"gociv/pkg/config"
-	"gociv/pkg/data"
 	"math"
 )
 
@@ -36,28 +34,37 @@ func (sim *Sim) UpdateFields() {
 	}
 }
 
+// UpdateField grows the crops, mature crops wait to be harvested, see HarvestFieldTile
 func (sim *Sim) UpdateField(field *Field) {
 	for i, tile := range field.TileStatus {
-		if tile.Seeded {
-			field.TileStatus[i].GrowthStage += config.FieldGrowthRate
-			if field.TileStatus[i].GrowthStage >= 100 {
-				field.TileStatus[i].Seeded = false
-				field.TileStatus[i].GrowthStage = 0
-				foodItem, _ := data.GetItemDefinition(int(ItemTypeFood), field.SeedVariant)
-				if foodItem.ItemType != int(ItemTypeFood) {
-					fmt.Printf("Food item type is not Food: %v\n", foodItem.ItemType)
-					continue
-				}
-				sim.AddItem(Item{
-					Type:       ItemTypeFood,
-					Variant:    foodItem.Variant,
-					Efficiency: foodItem.Efficiency,
-				}, ItemLocation{LocationType: LocTile, TilePosition: field.Tiles[i]})
+		if tile.Seeded && !tile.IsMature() {
+			growth := config.FieldGrowthRate
+			if tile.Watered {
+				growth += config.FieldWateredGrowthBonus
 			}
+			field.TileStatus[i].GrowthStage = uint8(min(int(tile.GrowthStage)+growth, 100))
 		}
 	}
 }
 
+func (status FieldTileStatus) IsMature() bool {
+	return status.Seeded && status.GrowthStage >= 100
+}
+
+// GetFieldTile returns the field of a tile and the index of the tile in the field, nil if it's not a field tile
+func (sim *Sim) GetFieldTile(position TilePosition) (*Field, int) {
+	tile := sim.GetTileAt(position)
+	if tile.ZoneType != ZoneTypeField {
+		return nil, -1
+	}
+	field := &sim.Fields[tile.ZoneIndex]
+	index := GetZoneTileIndex(field, position)
+	if index == -1 {
+		return nil, -1
+	}
+	return field, index
+}
+
 func (sim *Sim) GetClosestField(tilePosition TilePosition) *Field {
 	var closestField *Field
 	var minDistance = -1
@@ -100,9 +107,67 @@ func (sim *Sim) GetGrowingTilesCount() int {
 	return count
 }
 
+func (field *Field) GetMatureTiles() []TilePosition {
+	var matureTiles []TilePosition
+	for i, tile := range field.Tiles {
+		if field.TileStatus[i].IsMature() {
+			matureTiles = append(matureTiles, tile)
+		}
+	}
+	return matureTiles
+}
+
+func (sim *Sim) GetMatureTilesCount() int {
+	count := 0
+	for _, field := range sim.Fields {
+		count += len(field.GetMatureTiles())
+	}
+	return count
+}
+
+// GetCropCount returns the number of crops growing or waiting to be harvested
+func (sim *Sim) GetCropCount() int {
+	return sim.GetGrowingTilesCount() + sim.GetMatureTilesCount()
+}
+
+func (sim *Sim) HasFreeFieldTile() bool {
+	for _, field := range sim.Fields {
+		if len(field.GetFreeTiles()) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// FindClosestFieldTile returns the closest tile with a mature crop, or with a growing one if mature is false
+// tiles reserved by other characters are skipped
+func (sim *Sim) FindClosestFieldTile(characterID int16, position TilePosition, mature bool) *TilePosition {
+	var closest *TilePosition
+	closestDistance := -1
+	for i := range sim.Fields {
+		tiles := sim.Fields[i].GetGrowingTiles()
+		if mature {
+			tiles = sim.Fields[i].GetMatureTiles()
+		}
+		for j := range tiles {
+			if sim.Reservations.IsTileReserved(tiles[j], characterID) {
+				continue
+			}
+			if distance := GetTileDistance(position, tiles[j]); closestDistance == -1 || distance < closestDistance {
+				closest = &tiles[j]
+				closestDistance = distance
+			}
+		}
+	}
+	return closest
+}
+
+// GetSuitableFieldTiles returns the free dirt tiles closest to the character to lay out a new field, see IsFreeForField
 func (sim *Sim) GetSuitableFieldTiles(character *Character) []TilePosition {
 	var suitableTiles []TilePosition
-	closestDirt := sim.ScanForTile(character.TilePosition, -1, TileTypeDirt)
+	closestDirt := sim.ScanForTileMatching(character.TilePosition, -1, func(tile *Tile) bool {
+		return tile.IsFreeForField()
+	})
 	if closestDirt != nil {
 		suitableTiles = append(suitableTiles, *closestDirt)
 		// BFS: visit all dirt tiles by order of distance
@@ -132,9 +197,9 @@ func (sim *Sim) GetSuitableFieldTiles(character *Character) []TilePosition {
 						continue
 					}
 
-					// Check if tile is dirt
+					// Check if tile is free dirt
 					tileIndex := newY*config.RegionSize + newX
-					if sim.Tiles[tileIndex].Type != TileTypeDirt {
+					if !sim.Tiles[tileIndex].IsFreeForField() {
 						// Mark as visited but don't explore further
 						visited[key] = true
 						continue
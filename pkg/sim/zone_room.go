@@ -0,0 +1,80 @@
+package sim
+
+import (
+	"fmt"
+	"gociv/pkg/config"
+)
+
+func (r Room) GetCentroid() TilePosition {
+	return r.Centroid
+}
+
+func (r Room) GetTiles() []TilePosition {
+	return r.Tiles
+}
+
+// UpdateRooms detects enclosed rooms: areas of passable tiles fully surrounded by walls
+// It must be called whenever tiles change type
+func (sim *Sim) UpdateRooms() {
+	sim.Rooms = nil
+	for i := range sim.Tiles {
+		if sim.Tiles[i].ZoneType == ZoneTypeRoom {
+			sim.Tiles[i].ZoneType = ZoneTypeNone
+			sim.Tiles[i].ZoneIndex = 0
+		}
+	}
+
+	visited := make([]bool, len(sim.Tiles))
+	for i := range sim.Tiles {
+		if visited[i] || sim.Tiles[i].Type == TileTypeWall {
+			continue
+		}
+		area, touchesBorder := sim.floodFillArea(sim.Tiles[i].Position, visited)
+		if touchesBorder || len(area) > config.MaxRoomSize {
+			continue
+		}
+		room := Room{
+			Centroid: GetZoneCentroid(area),
+			Tiles:    area,
+		}
+		sim.Rooms = append(sim.Rooms, room)
+		for _, position := range area {
+			tile := sim.GetTileAt(position)
+			// fields keep their zone, they are still considered outdoor
+			if tile.ZoneType == ZoneTypeNone {
+				tile.ZoneType = ZoneTypeRoom
+				tile.ZoneIndex = int8(len(sim.Rooms) - 1)
+			}
+		}
+	}
+	fmt.Printf("Detected %d rooms\n", len(sim.Rooms))
+}
+
+// floodFillArea returns all non wall tiles connected to start and whether the area reaches the region border
+// walls stop the fill, diagonals included since characters can move diagonally
+func (sim *Sim) floodFillArea(start TilePosition, visited []bool) (area []TilePosition, touchesBorder bool) {
+	queue := []TilePosition{start}
+	visited[sim.GetTileIDFromPosition(start)] = true
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		area = append(area, current)
+		if current.X == 0 || current.Y == 0 || current.X == config.RegionSize-1 || current.Y == config.RegionSize-1 {
+			touchesBorder = true
+		}
+		for _, dir := range EightDirections {
+			newX, newY := current.X+int16(dir[0]), current.Y+int16(dir[1])
+			if newX < 0 || newX >= config.RegionSize || newY < 0 || newY >= config.RegionSize {
+				continue
+			}
+			neighbor := TilePosition{X: newX, Y: newY}
+			tileIndex := sim.GetTileIDFromPosition(neighbor)
+			if visited[tileIndex] || sim.Tiles[tileIndex].Type == TileTypeWall {
+				continue
+			}
+			visited[tileIndex] = true
+			queue = append(queue, neighbor)
+		}
+	}
+	return area, touchesBorder
+}